@@ -37,5 +37,14 @@ func New(opts ...Option) *analysis.Analyzer {
 	return a
 }
 
+// NewStrict creates a new instance of the scopeguard analyzer with all checks enabled.
+//
+// It enables the analyzers of [config.StrictAnalyzers] and the behavior of [config.StrictBehavior],
+// declaration combining and renaming of shadowed variables. Additional [Option] values are
+// applied afterwards and can override these settings.
+func NewStrict(opts ...Option) *analysis.Analyzer {
+	return New(strictOption{}, Options(opts))
+}
+
 // Analyzer is a pre-configured *[analysis.Analyzer] for detecting variables that can be moved to tighter scopes.
 var Analyzer = New()
@@ -17,9 +17,25 @@
 package analyzer_test
 
 import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/types"
+	"log/slog"
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
 
+	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/analysis/analysistest"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
 
 	. "fillmore-labs.com/scopeguard/analyzer"
 )
@@ -41,10 +57,65 @@ func TestAnalyzer(t *testing.T) {
 			options: Options{WithGenerated(true), WithMaxLines(5)},
 			fix:     true,
 		},
+		{
+			name:    "Parallel",
+			dir:     "./a",
+			options: Options{WithGenerated(true), WithMaxLines(5), WithParallel(true)},
+			fix:     true,
+		},
+		{
+			name:    "DeadInit",
+			dir:     "./deadinit",
+			options: WithDeadInit(true),
+			fix:     true,
+		},
 		{
 			name: "NoFix",
 			dir:  "./nofix",
 		},
+		{
+			name:    "PerfHints",
+			dir:     "./perfhints",
+			options: WithPerfHints(true),
+		},
+		{
+			name:    "IgnoreDebugPrints",
+			dir:     "./debugprint",
+			options: WithIgnoreDebugPrints(true),
+		},
+		{
+			name:    "TestBehavior",
+			dir:     "./testbehavior",
+			options: WithTestBehavior(Options{WithScope(false)}),
+		},
+		{
+			name:    "SkipClosures",
+			dir:     "./closures",
+			options: WithAnalyzeClosures(false),
+		},
+		{
+			name:    "PreferBlock",
+			dir:     "./preferblock",
+			options: WithPreferBlock(true),
+			fix:     true,
+		},
+		{
+			name:    "Simplify",
+			dir:     "./simplify",
+			options: WithSimplify(true),
+			fix:     true,
+		},
+		{
+			name:    "ReportAtTarget",
+			dir:     "./attarget",
+			options: WithReportAtTarget(true),
+			fix:     true,
+		},
+		{
+			name:    "Color",
+			dir:     "./color",
+			options: WithColor(true),
+		},
 		{
 			name:    "Conservative",
 			dir:     "./conservative",
@@ -57,12 +128,184 @@ func TestAnalyzer(t *testing.T) {
 			options: WithCombine(true),
 			fix:     true,
 		},
+		{
+			name:    "MaxWidth",
+			dir:     "./maxwidth",
+			options: WithMaxWidth(40),
+			fix:     true,
+		},
+		{
+			name:    "MaxFuncSize",
+			dir:     "./maxfuncsize",
+			options: WithMaxFuncSize(4),
+		},
+		{
+			name:    "MaxAbsorb",
+			dir:     "./maxabsorb",
+			options: WithMaxAbsorb(1),
+			fix:     true,
+		},
+		{
+			name:    "GroupRelated",
+			dir:     "./group",
+			options: Options{WithCombine(true), WithGroupRelated(true)},
+			fix:     true,
+		},
+		{
+			name:    "LoopBody",
+			dir:     "./loopbody",
+			options: WithLoopBodyMoves(true),
+			fix:     true,
+		},
+		{
+			name:    "BranchInit",
+			dir:     "./branchinit",
+			options: Options{WithScope(false), WithBranchInit(true)},
+		},
+		{
+			name:    "LoopLast",
+			dir:     "./looplast",
+			options: Options{WithScope(false), WithLoopLast(true)},
+		},
+		{
+			name:    "RenameLimit",
+			dir:     "./renamelimit",
+			options: Options{WithScope(false), WithRename(true), WithRenameLimit(2)},
+			fix:     true,
+		},
+		{
+			name:    "LoopShadow",
+			dir:     "./loopshadow",
+			options: Options{WithShadow(false), WithLoopShadow(true)},
+		},
+		{
+			name:    "RangeShadow",
+			dir:     "./rangeshadow",
+			options: Options{WithShadow(false), WithRangeShadow(true)},
+		},
+		{
+			name:    "GoCapture",
+			dir:     "./gocapture",
+			options: WithGoCapture(true),
+		},
+		{
+			name:    "NoopShadow",
+			dir:     "./noopshadow",
+			options: Options{WithShadow(false), WithNoopShadow(true)},
+			fix:     true,
+		},
+		{
+			name:    "KeepDocumented",
+			dir:     "./documented",
+			options: WithKeepDocumentedDeclarations(true),
+			fix:     true,
+		},
+		{
+			name:    "SkipDocumented",
+			dir:     "./skipdocumented",
+			options: WithSkipDocumentedDeclarations(true),
+		},
+		{
+			name:    "FixComment",
+			dir:     "./fixcomment",
+			options: WithFixComment(true),
+			fix:     true,
+		},
+		{
+			name:    "Join",
+			dir:     "./join",
+			options: WithJoin(true),
+			fix:     true,
+		},
+		{
+			name:    "InlineRange",
+			dir:     "./inlinerange",
+			options: WithInlineRange(true),
+		},
+		{
+			name:    "LoopConst",
+			dir:     "./loopconst",
+			options: WithLoopConst(true),
+			fix:     true,
+		},
+		{
+			name:    "LoopConstReportOnly",
+			dir:     "./loopconstreport",
+			options: Options{WithLoopConst(true), WithReportOnly(true)},
+		},
+		{
+			name:    "AddressHints",
+			dir:     "./addresshints",
+			options: WithAddressHints(true),
+		},
+		{
+			name:    "TypeSwitchUnused",
+			dir:     "./tsunused",
+			options: WithTypeSwitchUnused(true),
+		},
+		{
+			name:    "KeepPragma",
+			dir:     "./keeppragma",
+			options: WithKeepPragma("keep:top", "keep:here"),
+		},
+		{
+			name:    "CondInline",
+			dir:     "./condinline",
+			options: WithCondInline(true),
+		},
+		{
+			name:    "Swap",
+			dir:     "./swap",
+			options: Options{WithConservative(true), WithSwap(true)},
+			fix:     true,
+		},
+		{
+			name:    "IntroduceBlocks",
+			dir:     "./introblock",
+			options: WithIntroduceBlocks(true),
+			fix:     true,
+		},
+		{
+			name:    "TargetKinds",
+			dir:     "./targetkinds",
+			options: WithTargetKinds(IfInit, Block),
+			fix:     true,
+		},
+		{
+			name:    "OnlyErrorVars",
+			dir:     "./onlyerrors",
+			options: WithOnlyErrorVars(true),
+			fix:     true,
+		},
+		{
+			name:    "MinSpan",
+			dir:     "./minspan",
+			options: WithMinSpan(5),
+			fix:     true,
+		},
 		{
 			name:    "Rename",
 			dir:     "./rename",
 			options: Options{WithScope(false), WithNestedAssign(false), WithRename(true)},
 			fix:     true,
 		},
+		{
+			name:    "QuoteStyle",
+			dir:     "./quote",
+			options: Options{WithQuoteStyle(QuoteBacktick)},
+		},
+		{
+			name:    "RenameAndMove",
+			dir:     "./renamemove",
+			options: WithRename(true),
+			fix:     true,
+		},
+		{
+			name:    "ReportOnly",
+			dir:     "./reportonly",
+			options: Options{WithRename(true), WithReportOnly(true)},
+			fix:     true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -77,3 +320,703 @@ func TestAnalyzer(t *testing.T) {
 		})
 	}
 }
+
+func TestNewStrict(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		options []Option
+		want    map[string]string
+	}{
+		{
+			name: "Default",
+			want: map[string]string{
+				"scope": "true", "shadow": "true", "nested-assign": "true", "dead-init": "true",
+				"loop-shadow": "true", "range-shadow": "true", "branch-init": "true", "loop-last": "true",
+				"go-capture": "true", "noop-shadow": "true", "join": "true", "inline-range": "true",
+				"loop-const": "true", "ts-unused": "true", "cond-inline": "true", "swap": "true",
+				"combine": "true", "rename": "true", "conservative": "false",
+			},
+		},
+		{
+			name:    "Override",
+			options: []Option{WithRename(false)},
+			want:    map[string]string{"rename": "false", "shadow": "true"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			a := NewStrict(tt.options...)
+
+			for name, want := range tt.want {
+				f := a.Flags.Lookup(name)
+				if f == nil {
+					t.Fatalf("Flag %q not found", name)
+				}
+
+				if got := f.Value.String(); got != want {
+					t.Errorf("Flag %q = %s, want %s", name, got, want)
+				}
+			}
+		})
+	}
+
+	// Every analyzer flag is covered by the default expectations.
+	NewStrict().Flags.VisitAll(func(f *flag.Flag) {
+		if _, ok := tests[0].want[f.Name]; !ok && strings.HasSuffix(f.Usage, " analysis") {
+			t.Errorf("Analyzer flag %q not checked", f.Name)
+		}
+	})
+}
+
+func TestRunWithInspector(t *testing.T) {
+	t.Parallel()
+
+	testdata := analysistest.TestData()
+
+	opts := Options{WithGenerated(true), WithMaxLines(5)}
+	a := &analysis.Analyzer{
+		Name: "scopeguard",
+		Doc:  "scopeguard with a provided inspector",
+		Run: func(p *analysis.Pass) (any, error) {
+			return opts.RunWithInspector(p, inspector.New(p.Files))
+		},
+		ResultType: reflect.TypeFor[*Result](),
+	}
+
+	analysistest.RunWithSuggestedFixes(t, testdata, a, "./a")
+}
+
+func TestParallelOrder(t *testing.T) {
+	t.Parallel()
+
+	testdata := analysistest.TestData()
+
+	positions := func(opts ...Option) []string {
+		var got []string
+
+		for _, r := range analysistest.Run(t, testdata, New(opts...), "./a") {
+			for _, d := range r.Diagnostics {
+				got = append(got, r.Pass.Fset.Position(d.Pos).String())
+			}
+		}
+
+		return got
+	}
+
+	want := positions(WithGenerated(true), WithMaxLines(5))
+	got := positions(WithGenerated(true), WithMaxLines(5), WithParallel(true))
+
+	if !slices.Equal(got, want) {
+		t.Errorf("Parallel diagnostics order differs:\ngot  %v\nwant %v", got, want)
+	}
+}
+
+func TestExplain(t *testing.T) {
+	t.Parallel()
+
+	testdata := analysistest.TestData()
+
+	tests := []struct {
+		name   string
+		status string
+		reason string
+		target bool
+	}{
+		{name: "moved", status: "mov", target: true},
+		{name: "loop", reason: "usage crosses a loop boundary"},
+		{name: "inner", reason: "already at the innermost scope"},
+		{name: "param", reason: "already at the innermost scope"},
+	}
+
+	got := make(map[string]Explanation)
+
+	a := &analysis.Analyzer{
+		Name:     "explain",
+		Doc:      "explain scopeguard decisions",
+		Requires: []*analysis.Analyzer{inspect.Analyzer},
+		Run: func(p *analysis.Pass) (any, error) {
+			in := p.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+			for id, obj := range p.TypesInfo.Defs {
+				if _, ok := obj.(*types.Var); !ok {
+					continue
+				}
+
+				e, err := Explain(p, in, id.Pos())
+				if err != nil {
+					return nil, err
+				}
+
+				got[id.Name] = e
+			}
+
+			return nil, nil
+		},
+	}
+
+	analysistest.Run(t, testdata, a, "./explain")
+
+	for _, tt := range tests {
+		e, ok := got[tt.name]
+		if !ok {
+			t.Errorf("No explanation for %q", tt.name)
+			continue
+		}
+
+		if e.Status != tt.status || e.Reason != tt.reason || (e.TargetNode != nil) != tt.target {
+			t.Errorf("Explain(%q) = status %q, reason %q, target %T, want status %q, reason %q, target %t",
+				tt.name, e.Status, e.Reason, e.TargetNode, tt.status, tt.reason, tt.target)
+		}
+	}
+}
+
+func TestVerboseSkips(t *testing.T) {
+	t.Parallel()
+
+	testdata := analysistest.TestData()
+
+	var out syncBuilder
+
+	logger := slog.New(slog.NewTextHandler(&out, nil))
+	a := New(WithVerboseSkips(logger))
+
+	analysistest.Run(t, testdata, a, "./nofix")
+
+	log := out.String()
+
+	for _, reason := range []string{
+		"nolint directive on function",
+		"nolint directive",
+		"usage crosses a loop boundary",
+		"usage crosses a function literal boundary",
+		"context cancellation function",
+		"recursive closure",
+		"uses unreachable in target scope",
+	} {
+		if !strings.Contains(log, "reason=\""+reason+"\"") {
+			t.Errorf("Expected skip reason %q in log:\n%s", reason, log)
+		}
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	t.Parallel()
+
+	testdata := analysistest.TestData()
+
+	metrics := make(map[string]FuncMetrics)
+	a := New(WithCombine(false), WithMetrics(func(m FuncMetrics) { metrics[m.Func.Name.Name] = m }))
+
+	analysistest.Run(t, testdata, a, "./metrics")
+
+	if m := metrics["findings"]; m.Movable != 1 || m.Blocked["ini"] != 2 || m.Shadows != 1 || m.NestedAssigns != 1 {
+		t.Errorf("Got metrics %+v for findings, want 1 movable, 2 init conflicts, 1 shadow and 1 nested assignment", m)
+	}
+
+	if m, ok := metrics["empty"]; !ok || m.Movable != 0 || len(m.Blocked) != 0 {
+		t.Errorf("Got metrics %+v for empty, want no findings", m)
+	}
+}
+
+func TestBaseline(t *testing.T) {
+	t.Parallel()
+
+	testdata := analysistest.TestData()
+
+	a := New(WithBaseline(filepath.Join(testdata, "baseline", "baseline.txt")))
+
+	analysistest.Run(t, testdata, a, "./baseline")
+}
+
+func TestNestedParen(t *testing.T) {
+	t.Parallel()
+
+	// gofmt removes nested parentheses, so the source is written at test time.
+	const src = `package paren
+
+func nestedParen() {
+	var err error
+	(err) = func() error {
+		((err)) = error(nil) // want "Nested reassignment of variable 'err'"
+		return err
+	}()
+	_ = err
+}
+`
+
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"go.mod":         "module test\n\ngo 1.24\n",
+		"paren/paren.go": src,
+	} {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	analysistest.Run(t, dir, New(), "./paren")
+}
+
+func TestChangedLines(t *testing.T) {
+	t.Parallel()
+
+	testdata := analysistest.TestData()
+
+	a := New(WithChangedLines(map[string][]LineRange{
+		"changedlines/changedlines.go": {{Start: 28, End: 33}, {Start: 38, End: 38}},
+	}))
+
+	analysistest.Run(t, testdata, a, "./changedlines")
+}
+
+func TestMovableAt(t *testing.T) {
+	t.Parallel()
+
+	testdata := analysistest.TestData()
+
+	a := &analysis.Analyzer{
+		Name:     "movable",
+		Doc:      "reports moves found by MovableAt for every declared variable",
+		Requires: []*analysis.Analyzer{inspect.Analyzer},
+		Run: func(p *analysis.Pass) (any, error) {
+			in := p.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+			seen := make(map[ast.Node]struct{})
+
+			for c := range in.Root().Preorder((*ast.Ident)(nil)) {
+				id := c.Node().(*ast.Ident)
+				if _, ok := p.TypesInfo.Defs[id].(*types.Var); !ok {
+					continue
+				}
+
+				m, ok := MovableAt(p, in, id.Pos())
+				if !ok {
+					continue
+				}
+
+				if _, ok := seen[m.Decl]; ok {
+					continue
+				}
+
+				seen[m.Decl] = struct{}{}
+
+				p.Report(analysis.Diagnostic{
+					Pos:            m.Decl.Pos(),
+					Message:        m.Fix.Message,
+					SuggestedFixes: []analysis.SuggestedFix{m.Fix},
+				})
+			}
+
+			return nil, nil
+		},
+	}
+
+	analysistest.RunWithSuggestedFixes(t, testdata, a, "./movable")
+}
+
+func TestRenameLimitLog(t *testing.T) {
+	t.Parallel()
+
+	testdata := analysistest.TestData()
+
+	var out syncBuilder
+
+	logger := slog.New(slog.NewTextHandler(&out, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	a := New(WithScope(false), WithRename(true), WithRenameLimit(2), WithVerboseSkips(logger))
+
+	analysistest.Run(t, testdata, a, "./renamelimit")
+
+	if log := out.String(); !strings.Contains(log, "msg=\"Rename limit exhausted\"") || !strings.Contains(log, "variable=x") {
+		t.Errorf("Expected exhausted rename limit for x in log:\n%s", log)
+	}
+}
+
+// syncBuilder is a [strings.Builder] safe for concurrent use.
+type syncBuilder struct {
+	mu sync.Mutex
+	sb strings.Builder
+}
+
+func (b *syncBuilder) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.sb.Write(p)
+}
+
+func (b *syncBuilder) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.sb.String()
+}
+
+func TestTargetSnippets(t *testing.T) {
+	t.Parallel()
+
+	testdata := analysistest.TestData()
+
+	var got []string
+
+	for _, r := range analysistest.Run(t, testdata, New(WithTargetSnippets(true)), "./snippets") {
+		for _, d := range r.Diagnostics {
+			for _, related := range d.Related {
+				got = append(got, related.Message)
+			}
+		}
+	}
+
+	want := []string{
+		"To this if scope: `if x > 0 {`",
+		"To this block scope: `if verbose {`",
+		"To this block scope: `if aVeryLongConditionName && anotherVer…`",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("Got related information %q, want %q", got, want)
+	}
+}
+
+func TestExplainUnused(t *testing.T) {
+	t.Parallel()
+
+	testdata := analysistest.TestData()
+
+	var got []string
+
+	for _, r := range analysistest.Run(t, testdata, New(WithExplainUnused(true)), "./explainunused") {
+		for _, d := range r.Diagnostics {
+			for _, related := range d.Related {
+				got = append(got, related.Message)
+			}
+		}
+	}
+
+	want := []string{
+		"Variable 'err' is reassigned here before being read",
+		"To this if scope",
+		"To this if scope",
+		"Variable 'ok' becomes unused after moving this declaration",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("Got related information %q, want %q", got, want)
+	}
+}
+
+func TestReportBlocked(t *testing.T) {
+	t.Parallel()
+
+	testdata := analysistest.TestData()
+
+	var got []string
+
+	for _, r := range analysistest.Run(t, testdata, New(WithConservative(true), WithReportBlocked(true)), "./reportblocked") {
+		for _, d := range r.Diagnostics {
+			for _, related := range d.Related {
+				got = append(got, related.Message)
+			}
+		}
+	}
+
+	want := []string{
+		"To this block scope",
+		"Move blocked: intervening statements may have side effects",
+		"To this if scope",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("Got related information %q, want %q", got, want)
+	}
+}
+
+func TestIndentFix(t *testing.T) {
+	t.Parallel()
+
+	testdata := analysistest.TestData()
+
+	var got []string
+
+	// analysistest formats fixed files, so check the inserted text directly
+	for _, r := range analysistest.Run(t, testdata, New(WithIndentFix(true)), "./indentfix") {
+		for _, d := range r.Diagnostics {
+			for _, fix := range d.SuggestedFixes {
+				for _, edit := range fix.TextEdits {
+					if len(edit.NewText) > 0 {
+						got = append(got, string(edit.NewText))
+					}
+				}
+			}
+		}
+	}
+
+	want := []string{
+		"\n\t\t\tx := 1",
+		"\n\t\ty := 2",
+		"\n\t\tp := struct {\n\t\t\ta, b int\n\t\t}{\n\t\t\ta:\t1,\n\t\t\tb:\t2,\n\t\t}",
+		"\n\t\ts := fmt.Sprint(\n\t`first\nsecond`)",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("Got inserted text %q, want %q", got, want)
+	}
+}
+
+func TestIntroduceBlocksBraces(t *testing.T) {
+	t.Parallel()
+
+	testdata := analysistest.TestData()
+
+	var got []string
+
+	// analysistest formats fixed files, so check the inserted braces directly
+	for _, r := range analysistest.Run(t, testdata, New(WithIntroduceBlocks(true)), "./introblock") {
+		for _, d := range r.Diagnostics {
+			for _, fix := range d.SuggestedFixes {
+				for _, edit := range fix.TextEdits {
+					got = append(got, string(edit.NewText))
+				}
+			}
+		}
+	}
+
+	openBrace, closeBrace := "{\n\t", "\n\t}"
+	want := []string{
+		openBrace, closeBrace, // basic
+		openBrace, closeBrace, // usedAfter
+		openBrace, closeBrace, // nested x
+		openBrace, closeBrace, // nested y
+		openBrace, closeBrace, // trailingComment
+		"{\n\t\t", "\n\t\t}", // clause
+		openBrace, closeBrace, // varDecl
+		openBrace, closeBrace, // rawString
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("Got inserted text %q, want %q", got, want)
+	}
+}
+
+func TestIntroduceBlocksIndent(t *testing.T) {
+	t.Parallel()
+
+	testdata := analysistest.TestData()
+
+	type insertion struct {
+		offset int
+		text   string
+	}
+
+	// analysistest formats fixed files, so apply the inserted text directly
+	insertions := make(map[string][]insertion)
+	for _, r := range analysistest.Run(t, testdata, New(WithIntroduceBlocks(true), WithIndentFix(true)), "./introblock") {
+		for _, d := range r.Diagnostics {
+			for _, fix := range d.SuggestedFixes {
+				for _, edit := range fix.TextEdits {
+					tf := r.Pass.Fset.File(edit.Pos)
+					insertions[tf.Name()] = append(insertions[tf.Name()], insertion{tf.Offset(edit.Pos), string(edit.NewText)})
+				}
+			}
+		}
+	}
+
+	if len(insertions) == 0 {
+		t.Fatal("No suggested fixes")
+	}
+
+	for name, ins := range insertions {
+		src, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		slices.SortStableFunc(ins, func(a, b insertion) int { return a.offset - b.offset })
+
+		var fixed strings.Builder
+		last := 0
+		for _, i := range ins {
+			fixed.Write(src[last:i.offset])
+			fixed.WriteString(i.text)
+			last = i.offset
+		}
+		fixed.Write(src[last:])
+
+		formatted, err := format.Source([]byte(fixed.String()))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// gofmt may realign comments, so only compare the indentation
+		got, want := strings.Split(fixed.String(), "\n"), strings.Split(string(formatted), "\n")
+		if len(got) != len(want) {
+			t.Fatalf("Fixed %s has %d lines, formatted %d:\n%s", filepath.Base(name), len(got), len(want), fixed.String())
+		}
+
+		for i := range got {
+			if g, w := indentation(got[i]), indentation(want[i]); g != w {
+				t.Errorf("%s:%d: Got indentation %q, want %q", filepath.Base(name), i+1, g, w)
+			}
+		}
+	}
+}
+
+// indentation returns the leading tabs of a line.
+func indentation(line string) string {
+	return line[:len(line)-len(strings.TrimLeft(line, "\t"))]
+}
+
+func TestRenameGenerated(t *testing.T) {
+	t.Parallel()
+
+	testdata := analysistest.TestData()
+
+	tests := []struct {
+		name    string
+		options Options
+		want    bool
+	}{
+		{"default", Options{WithGenerated(true), WithRename(true)}, false},
+		{"rename", Options{WithGenerated(true), WithRename(true), WithRenameGenerated(true)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// analysistest skips fixes in generated files, so check them directly
+			for _, r := range analysistest.Run(t, testdata, New(tt.options...), "./renamegenerated") {
+				if len(r.Diagnostics) == 0 {
+					t.Fatal("Expected diagnostics")
+				}
+
+				for _, d := range r.Diagnostics {
+					if got := len(d.SuggestedFixes) > 0; got != tt.want {
+						t.Errorf("Got fixes %t for %q, want %t", got, d.Message, tt.want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestReportCategory(t *testing.T) {
+	t.Parallel()
+
+	testdata := analysistest.TestData()
+
+	tests := []struct {
+		name    string
+		options Options
+		want    string
+	}{
+		{"default", nil, "scopeguard"},
+		{"custom", Options{WithReportCategory("lint/scope")}, "lint/scope"},
+		{"empty", Options{WithReportCategory("")}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, r := range analysistest.Run(t, testdata, New(tt.options...), "./snippets") {
+				if len(r.Diagnostics) == 0 {
+					t.Fatal("Expected diagnostics")
+				}
+
+				for _, d := range r.Diagnostics {
+					if d.Category != tt.want {
+						t.Errorf("Got category %q for %q, want %q", d.Category, d.Message, tt.want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestResult(t *testing.T) {
+	t.Parallel()
+
+	testdata := analysistest.TestData()
+
+	scopeRanges := func(opts ...Option) []string {
+		var got []string
+
+		for _, r := range analysistest.Run(t, testdata, New(opts...), "./a") {
+			result, ok := r.Result.(*Result)
+			if !ok {
+				t.Fatalf("Unexpected result type %T", r.Result)
+			}
+
+			for sr := range result.AllScopeRanges() {
+				if !sr.DeclScope.Contains(sr.UsageScope.Pos()) && sr.DeclScope != sr.UsageScope {
+					t.Errorf("Usage scope of declaration at %s outside of declaration scope", r.Pass.Fset.Position(sr.Decl.Pos()))
+				}
+
+				got = append(got, r.Pass.Fset.Position(sr.Decl.Pos()).String())
+			}
+		}
+
+		return got
+	}
+
+	want := scopeRanges(WithGenerated(true), WithMaxLines(5))
+	if len(want) == 0 {
+		t.Fatal("Expected scope ranges")
+	}
+
+	got := scopeRanges(WithGenerated(true), WithMaxLines(5), WithParallel(true))
+	if !slices.Equal(got, want) {
+		t.Errorf("Parallel scope ranges differ:\ngot  %v\nwant %v", got, want)
+	}
+}
+
+func TestQueryVar(t *testing.T) {
+	t.Parallel()
+
+	testdata := analysistest.TestData()
+
+	got := make(map[string]string)
+
+	for _, r := range analysistest.Run(t, testdata, New(WithTargetKinds(IfInit, Block)), "./targetkinds") {
+		result, ok := r.Result.(*Result)
+		if !ok {
+			t.Fatalf("Unexpected result type %T", r.Result)
+		}
+
+		for _, f := range r.Pass.Files {
+			for _, decl := range f.Decls {
+				fn, ok := decl.(*ast.FuncDecl)
+				if !ok {
+					continue
+				}
+
+				ast.Inspect(fn.Body, func(n ast.Node) bool {
+					id, ok := n.(*ast.Ident)
+					if !ok || id.Name != "n" {
+						return true
+					}
+
+					if v, ok := r.Pass.TypesInfo.Defs[id].(*types.Var); ok {
+						if info, ok := result.QueryVar(v); ok {
+							got[fn.Name.Name] = fmt.Sprintf("%v fixable=%t", info.Kind, info.Fixable)
+						}
+					}
+
+					return true
+				})
+			}
+		}
+	}
+
+	want := map[string]string{
+		"ifInit":    "if-init fixable=true",
+		"body":      "block fixable=true",
+		"caseBlock": "block fixable=true",
+	}
+	if !maps.Equal(got, want) {
+		t.Errorf("Got moves %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer_test
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/inspector"
+
+	. "fillmore-labs.com/scopeguard/analyzer"
+)
+
+func BenchmarkGetters(b *testing.B) {
+	p, in := gettersPass(b, 500)
+
+	for _, fastPath := range []bool{false, true} {
+		b.Run(fmt.Sprintf("fastPath=%t", fastPath), func(b *testing.B) {
+			opts := Options{WithFastPath(fastPath)}
+
+			for b.Loop() {
+				if _, err := opts.RunWithInspector(p, in); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// gettersPass creates an [analysis.Pass] for a package of n getter methods.
+func gettersPass(tb testing.TB, n int) (*analysis.Pass, *inspector.Inspector) {
+	tb.Helper()
+
+	var src strings.Builder
+
+	src.WriteString("package getters\n\ntype T struct {\n")
+
+	for i := range n {
+		fmt.Fprintf(&src, "\tf%d int\n", i)
+	}
+
+	src.WriteString("}\n")
+
+	for i := range n {
+		fmt.Fprintf(&src, "\nfunc (t *T) F%d() int { return t.f%d }\n", i, i)
+	}
+
+	fset := token.NewFileSet()
+
+	f, err := parser.ParseFile(fset, "getters.go", src.String(), parser.SkipObjectResolution)
+	if err != nil {
+		tb.Fatalf("Failed to parse source: %v", err)
+	}
+
+	info := &types.Info{
+		Types:        make(map[ast.Expr]types.TypeAndValue),
+		Defs:         make(map[*ast.Ident]types.Object),
+		Uses:         make(map[*ast.Ident]types.Object),
+		Scopes:       make(map[ast.Node]*types.Scope),
+		FileVersions: make(map[*ast.File]string),
+	}
+
+	files := []*ast.File{f}
+
+	pkg, err := new(types.Config).Check("getters", fset, files, info)
+	if err != nil {
+		tb.Fatalf("Failed to type check source: %v", err)
+	}
+
+	p := &analysis.Pass{
+		Fset:      fset,
+		Files:     files,
+		Pkg:       pkg,
+		TypesInfo: info,
+		Report:    func(analysis.Diagnostic) {},
+	}
+
+	return p, inspector.New(files)
+}
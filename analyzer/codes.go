@@ -0,0 +1,89 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import "fillmore-labs.com/scopeguard/internal/target/check"
+
+// CodeInfo describes a diagnostic code, shown in parentheses at the end of diagnostic messages.
+type CodeInfo struct {
+	// Code is the diagnostic code, like "sg:mov".
+	Code string
+
+	// Description is a human-readable description of the finding.
+	Description string
+
+	// Flag is the name of the flag enabling diagnostics with this code.
+	Flag string
+
+	// Default indicates the diagnostics are reported without any [Option].
+	Default bool
+}
+
+// codes lists the diagnostic codes of findings other than moves, in flag order.
+var codes = []CodeInfo{
+	{Code: "sg:uas", Description: "Identifier used after previously shadowed", Flag: "shadow"},
+	{Code: "sg:nst", Description: "Nested reassignment of a variable", Flag: "nested-assign"},
+	{Code: "sg:dead-init", Description: "Initial value overwritten before being read", Flag: "dead-init"},
+	{Code: "sg:loop-shadow", Description: "Declaration shadowing a loop variable", Flag: "loop-shadow"},
+	{Code: "sg:range-shadow", Description: "Declaration shadowing a range variable", Flag: "range-shadow"},
+	{Code: "sg:branch-init", Description: "Zero value overwritten on all branches", Flag: "branch-init"},
+	{Code: "sg:loop-last", Description: "Variable only holding the last value assigned in a loop", Flag: "loop-last"},
+	{Code: "sg:capture", Description: "Variable only used in a goroutine, passable as a parameter", Flag: "go-capture"},
+	{Code: "sg:noop-shadow", Description: "Variable redeclared as itself", Flag: "noop-shadow"},
+	{Code: "sg:join", Description: "Adjacent declarations that can be joined", Flag: "join"},
+	{Code: "sg:inline-range", Description: "Variable only used as a range expression", Flag: "inline-range"},
+	{Code: "sg:loop-const", Description: "Variable bounding a loop that can be a constant", Flag: "loop-const"},
+	{Code: "sg:ts-unused", Description: "Type switch variable unused in a case", Flag: "ts-unused"},
+	{Code: "sg:cond-inline", Description: "Variable only used in the if condition", Flag: "cond-inline"},
+	{Code: "sg:swap", Description: "Move unblocked by swapping with the following statement", Flag: "swap"},
+	{Code: "sg:intro-block", Description: "Variable that can be scoped by introducing a block", Flag: "introduce-blocks"},
+	{Code: "sg:skip", Description: "Function not analyzed because of its size", Flag: "max-func-size"},
+}
+
+// Codes returns all diagnostic codes reported by scopeguard, starting with the move status codes.
+//
+// This is intended for documentation generation and configuration tools, which can enable
+// the codes of interest by their flag.
+func Codes() []CodeInfo {
+	flags := New().Flags
+
+	var infos []CodeInfo
+
+	for status := check.MoveAllowed; status <= check.MoveBlockedDocumented; status++ {
+		description := "Move blocked: " + status.Reason()
+
+		switch status {
+		case check.MoveAllowed:
+			description = "Declaration can be moved to a tighter scope"
+
+		case check.MoveAbsorbed:
+			description = "Declaration combined into another move"
+		}
+
+		infos = append(infos, CodeInfo{Code: "sg:" + status.String(), Description: description, Flag: "scope"})
+	}
+
+	infos = append(infos, codes...)
+
+	for i := range infos {
+		if f := flags.Lookup(infos[i].Flag); f != nil {
+			infos[i].Default = f.DefValue == "true"
+		}
+	}
+
+	return infos
+}
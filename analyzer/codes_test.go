@@ -0,0 +1,76 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer_test
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	. "fillmore-labs.com/scopeguard/analyzer"
+)
+
+func TestCodes(t *testing.T) {
+	t.Parallel()
+
+	flags := New().Flags
+	known := make(map[string]CodeInfo)
+
+	for _, info := range Codes() {
+		if _, ok := known[info.Code]; ok {
+			t.Errorf("Duplicate code %s", info.Code)
+		}
+
+		if info.Description == "" {
+			t.Errorf("Code %s has no description", info.Code)
+		}
+
+		if flags.Lookup(info.Flag) == nil {
+			t.Errorf("Code %s has unknown flag %q", info.Code, info.Flag)
+		}
+
+		known[info.Code] = info
+	}
+
+	for code, want := range map[string]bool{"sg:mov": true, "sg:xst": true, "sg:uas": true, "sg:join": false, "sg:intro-block": false} {
+		if got := known[code].Default; got != want {
+			t.Errorf("Default of %s is %t, want %t", code, got, want)
+		}
+	}
+
+	// All codes in diagnostic messages must be registered
+	files, err := filepath.Glob(filepath.Join("..", "internal", "report", "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	re := regexp.MustCompile(`\(sg:([a-z-]+)\)`)
+
+	for _, file := range files {
+		src, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, m := range re.FindAllStringSubmatch(string(src), -1) {
+			if _, ok := known["sg:"+m[1]]; !ok {
+				t.Errorf("Unregistered code sg:%s in %s", m[1], filepath.Base(file))
+			}
+		}
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/edge"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"fillmore-labs.com/scopeguard/internal/astutil"
+	"fillmore-labs.com/scopeguard/internal/scope"
+)
+
+// ErrNoDeclaration is returned by [Explain] when there is no variable declaration at the given position.
+var ErrNoDeclaration = errors.New("no variable declaration")
+
+// Explanation is the decision trace of the scopeguard pipeline for a single variable declaration.
+type Explanation struct {
+	// Var is the declared variable, Decl the declaration statement.
+	Var  *types.Var
+	Decl ast.Node
+
+	// DeclScope is the scope of the declaration.
+	DeclScope *types.Scope
+
+	// UsageScope is the tightest scope containing all uses of the declaration.
+	UsageScope *types.Scope
+
+	// SafeScope is the tightest scope the declaration can be moved to without crossing loops or function literals.
+	SafeScope *types.Scope
+
+	// TargetNode is the node with the target scope (e.g., *[ast.IfStmt], *[ast.BlockStmt]), if any.
+	TargetNode ast.Node
+
+	// Status is the move status code of the diagnostic (e.g. "mov", "typ"), or empty if the declaration is not reported.
+	Status string
+
+	// Movable indicates the declaration can be moved.
+	Movable bool
+
+	// Reason explains why the declaration is not a move candidate, if it isn't.
+	Reason string
+}
+
+// Explain runs the scopeguard pipeline for the function containing the variable declared at pos
+// and returns the decisions made for its declaration.
+//
+// This is intended for debugging: it records intermediate results the analyzer otherwise discards.
+func Explain(p *analysis.Pass, in *inspector.Inspector, pos token.Pos, opts ...Option) (Explanation, error) {
+	id, ok := in.Root().FindByPos(pos, pos)
+	if !ok {
+		return Explanation{}, fmt.Errorf("scopeguard: %w at %s", ErrNoDeclaration, p.Fset.Position(pos))
+	}
+
+	ident, ok := id.Node().(*ast.Ident)
+	if !ok {
+		return Explanation{}, fmt.Errorf("scopeguard: %w at %s", ErrNoDeclaration, p.Fset.Position(pos))
+	}
+
+	v, ok := p.TypesInfo.Defs[ident].(*types.Var)
+	if !ok {
+		return Explanation{}, fmt.Errorf("scopeguard: %w at %s", ErrNoDeclaration, p.Fset.Position(pos))
+	}
+
+	var (
+		decl, fdecl, file inspector.Cursor
+		hasDecl, hasFunc  bool
+	)
+
+	for c := range id.Enclosing((*ast.AssignStmt)(nil), (*ast.DeclStmt)(nil), (*ast.RangeStmt)(nil), (*ast.FuncDecl)(nil), (*ast.File)(nil)) {
+		switch c.Node().(type) {
+		case *ast.File:
+			file = c
+
+		case *ast.FuncDecl:
+			if !hasFunc {
+				fdecl, hasFunc = c, true
+			}
+
+		default:
+			if !hasDecl && !hasFunc {
+				decl, hasDecl = c, true
+			}
+		}
+	}
+
+	if !hasFunc || fdecl.Node().(*ast.FuncDecl).Body == nil {
+		return Explanation{}, fmt.Errorf("scopeguard: %w in function body at %s", ErrNoDeclaration, p.Fset.Position(pos))
+	}
+
+	if !hasDecl {
+		decl = fdecl // Parameters and results are recorded with the function declaration
+	}
+
+	cf := astutil.NewCurrentFile(p.Fset, file.Node().(*ast.File))
+
+	r := makeRunOptions(opts).forFile(p.Fset, file)
+	us, ts := r.stages(p, scope.NewIndex(p.TypesInfo.Scopes))
+
+	ctx := context.Background()
+	body := fdecl.ChildAt(edge.FuncDecl_Body, -1)
+	usageData, _ := us.TrackUsage(ctx, body, fdecl.Node().(*ast.FuncDecl))
+
+	e := Explanation{Var: v, Decl: decl.Node()}
+
+	d := ts.Explain(ctx, cf, body, usageData, astutil.NodeIndexOf(decl))
+
+	e.DeclScope, e.UsageScope, e.SafeScope = d.DeclScope, d.UsageScope, d.SafeScope
+	e.TargetNode, e.Reason = d.TargetNode, d.Reason
+
+	if d.Status != nil {
+		e.Status, e.Movable = d.Status.String(), d.Status.Movable()
+	}
+
+	return e, nil
+}
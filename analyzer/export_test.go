@@ -17,13 +17,154 @@
 package analyzer
 
 import (
+	"bytes"
+	"cmp"
+	"errors"
 	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"log/slog"
+	"slices"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/inspector"
 
 	"fillmore-labs.com/scopeguard/internal/config"
 )
 
+// WithFastPath is an [Option] to configure skipping functions without local declarations.
+// It is used to compare the fast path in benchmarks.
+func WithFastPath(fastPath bool) Option { return fastPathOption{fastPath: fastPath} }
+
+type fastPathOption struct{ fastPath bool }
+
+func (o fastPathOption) apply(r *runOptions) {
+	r.fastPath = o.fastPath
+}
+
+func (o fastPathOption) LogAttr() slog.Attr {
+	return slog.Bool("fast-path", o.fastPath)
+}
+
 // NewAnalyzerValue returns a new flag.Value that maps to the specified flag bit.
 // It is used to export the unexported flagValue type for testing.
 func NewAnalyzerValue(flags *config.BitMask[config.AnalyzerFlags], value config.AnalyzerFlags) flag.Getter {
 	return boolValue[config.AnalyzerFlags, *config.BitMask[config.AnalyzerFlags]]{flags: flags, value: value}
 }
+
+// ErrInvalidSource is returned by [RunSource] when the input does not parse or type check.
+var ErrInvalidSource = errors.New("invalid source")
+
+// RunSource parses and type checks a single Go source file, runs the strict configuration on it
+// and applies the suggested fixes. The fixed source is parsed and type checked again,
+// so invalid fix output results in an error.
+//
+// Input that is not valid Go is rejected with [ErrInvalidSource].
+func RunSource(src []byte, opts ...Option) ([]byte, error) {
+	fset, pass, err := checkSource("input.go", src)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSource, err)
+	}
+
+	var edits []analysis.TextEdit
+
+	pass.Report = func(d analysis.Diagnostic) {
+		for _, fix := range d.SuggestedFixes {
+			edits = append(edits, fix.TextEdits...)
+		}
+	}
+
+	r := makeRunOptions(Options{strictOption{}, Options(opts)})
+	if _, err := r.runInspector(pass, inspector.New(pass.Files)); err != nil {
+		return nil, err
+	}
+
+	fixed, err := applyEdits(fset.File(pass.Files[0].Pos()), src, edits)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, _, err := checkSource("fixed.go", fixed); err != nil {
+		return fixed, fmt.Errorf("fixed source is invalid: %w", err)
+	}
+
+	return fixed, nil
+}
+
+func checkSource(filename string, src []byte) (*token.FileSet, *analysis.Pass, error) {
+	fset := token.NewFileSet()
+
+	f, err := parser.ParseFile(fset, filename, src, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	info := &types.Info{
+		Types:        make(map[ast.Expr]types.TypeAndValue),
+		Defs:         make(map[*ast.Ident]types.Object),
+		Uses:         make(map[*ast.Ident]types.Object),
+		Implicits:    make(map[ast.Node]types.Object),
+		Selections:   make(map[*ast.SelectorExpr]*types.Selection),
+		Scopes:       make(map[ast.Node]*types.Scope),
+		FileVersions: make(map[*ast.File]string),
+	}
+
+	conf := types.Config{Importer: importer.Default()}
+
+	pkg, err := conf.Check(f.Name.Name, fset, []*ast.File{f}, info)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pass := &analysis.Pass{
+		Fset:      fset,
+		Files:     []*ast.File{f},
+		Pkg:       pkg,
+		TypesInfo: info,
+		Report:    func(analysis.Diagnostic) {},
+	}
+
+	return fset, pass, nil
+}
+
+// applyEdits applies the edits to src, ignoring duplicates and failing on conflicts.
+func applyEdits(file *token.File, src []byte, edits []analysis.TextEdit) ([]byte, error) {
+	slices.SortStableFunc(edits, func(a, b analysis.TextEdit) int {
+		return cmp.Or(cmp.Compare(a.Pos, b.Pos), cmp.Compare(a.End, b.End))
+	})
+
+	var (
+		buf  bytes.Buffer
+		last int
+		prev analysis.TextEdit
+	)
+
+	for i, edit := range edits {
+		end := edit.End
+		if !end.IsValid() {
+			end = edit.Pos
+		}
+
+		if i > 0 && edit.Pos == prev.Pos && end == prev.End && bytes.Equal(edit.NewText, prev.NewText) {
+			continue
+		}
+
+		start, stop := file.Offset(edit.Pos), file.Offset(end)
+		if start < last {
+			return nil, fmt.Errorf("conflicting edit at %s", file.Position(edit.Pos))
+		}
+
+		buf.Write(src[last:start])
+		buf.Write(edit.NewText)
+		last, prev = stop, analysis.TextEdit{Pos: edit.Pos, End: end, NewText: edit.NewText}
+	}
+
+	buf.Write(src[last:])
+
+	return format.Source(buf.Bytes())
+}
@@ -33,6 +33,19 @@ func registerFlags(flags *flag.FlagSet, r *runOptions) {
 		{config.ScopeAnalyzer, "scope", "scope analysis"},
 		{config.ShadowAnalyzer, "shadow", "shadow analysis"},
 		{config.NestedAssignAnalyzer, "nested-assign", "nested assign analysis"},
+		{config.DeadInitAnalyzer, "dead-init", "dead initial value analysis"},
+		{config.LoopShadowAnalyzer, "loop-shadow", "loop variable shadowing analysis"},
+		{config.RangeShadowAnalyzer, "range-shadow", "range variable shadowing analysis"},
+		{config.BranchInitAnalyzer, "branch-init", "zero values overwritten on all branches analysis"},
+		{config.LoopLastAnalyzer, "loop-last", "variables only holding a loop's final value analysis"},
+		{config.GoCaptureAnalyzer, "go-capture", "variables only read inside a goroutine analysis"},
+		{config.NoopShadowAnalyzer, "noop-shadow", "redundant redeclarations of variables as themselves analysis"},
+		{config.JoinAnalyzer, "join", "adjacent short declarations that can be joined analysis"},
+		{config.InlineRangeAnalyzer, "inline-range", "variables only used as a range expression analysis"},
+		{config.LoopConstAnalyzer, "loop-const", "loop bounds that can be constants analysis"},
+		{config.TypeSwitchUnusedAnalyzer, "ts-unused", "type switch variables unused in a case analysis"},
+		{config.CondInlineAnalyzer, "cond-inline", "if initializers only used in the condition analysis"},
+		{config.SwapAnalyzer, "swap", "moves blocked by a single swappable statement analysis"},
 	}
 
 	config := analyzeFlags[config.Config]{
@@ -40,14 +53,46 @@ func registerFlags(flags *flag.FlagSet, r *runOptions) {
 		{config.Conservative, "conservative", "enable conservative scope analysis"},
 		{config.CombineDeclarations, "combine", "combine declaration when moving to initializers"},
 		{config.RenameVariables, "rename", "rename shadowed variables (experimental)"},
+		{config.RenameGenerated, "rename-generated", "rename shadowed variables in analyzed generated files"},
+		{config.ReportOnly, "report-only", "report diagnostics without suggested fixes"},
+		{config.GroupRelated, "group-related", "report combined declarations in a single diagnostic"},
+		{config.ReportBlocked, "report-blocked", "report blocked moves with their reason, even in conservative mode"},
+		{config.ReportAtTarget, "report-at-target", "report movable declarations at the target scope"},
+		{config.TargetSnippets, "target-snippets", "quote the target scope in related information"},
+		{config.Color, "color", "highlight diagnostic messages with ANSI colors"},
+		{config.LoopBodyMoves, "loop-body", "move loop invariant declarations into loop bodies (Go 1.22+)"},
+		{config.PerfHints, "perf-hints", "mention initializers only evaluated when needed after moving"},
+		{config.AddressHints, "address-hints", "mention moved variables whose address is taken"},
+		{config.IntroduceBlocks, "introduce-blocks", "wrap declarations and the statements using them in new blocks"},
+		{config.IgnoreDebugPrints, "ignore-debug-prints", "disregard debug print arguments when computing scopes"},
+		{config.PreferBlock, "prefer-block", "move short declarations to blocks instead of control flow initializers"},
+		{config.ParallelFiles, "parallel", "analyze files of a package concurrently"},
+		{config.AnalyzeClosures, "closures", "analyze declarations inside function literals"},
+		{config.OnlyErrorVars, "only-errors", "only move declarations of variables named err or implementing error"},
+		{config.KeepDocumented, "keep-documented", "report moves of var declarations with doc comments without fixes"},
+		{config.SkipDocumented, "skip-documented", "don't report moves of var declarations with doc comments"},
+		{config.ExplainUnused, "explain-unused", "explain why variables are unused in related information"},
+		{config.FixComment, "fix-comment", "precede declarations moved to blocks with a comment naming the scopeguard version"},
+		{config.IndentFix, "indent-fix", "indent declarations moved to blocks for fixes applied without gofmt"},
+		{config.SimplifyDeclarations, "simplify", "rewrite moved var declarations with redundant types as short declarations"},
 	}
 
 	analyzers.register(flags, &r.analyzers)
 	config.register(flags, &r.behavior)
 	flags.IntVar(&r.maxLines, "max-lines", r.maxLines, "maximum declaration lines for moving to initializers")
+	flags.IntVar(&r.maxAbsorb, "max-absorb", r.maxAbsorb, "maximum declarations combined into another one (-1: unlimited)")
+	flags.IntVar(&r.maxWidth, "max-width", r.maxWidth, "maximum width of initializer lines with combined declarations (-1: unlimited)")
+	flags.IntVar(&r.maxFuncSize, "max-func-size", r.maxFuncSize, "maximum statements of analyzed functions (-1: unlimited)")
+	flags.IntVar(&r.minSpan, "min-span", r.minSpan, "minimum lines from declaration to end of usage scope for moving")
+	flags.IntVar(&r.renameLimit, "rename-limit", r.renameLimit, "maximum suffixes tried when renaming shadowed variables")
+	flags.StringVar(&r.baselineFile, "baseline", r.baselineFile, "file of accepted findings (file:line:name) not reported")
+	flags.StringVar(&r.category, "category", r.category, "category of reported diagnostics")
+	flags.Var(&r.keepPragmas, "keep-pragma", "comma-separated comments keeping declarations at their scope, like keep:top")
+	flags.TextVar(&r.targetKinds, "target-kinds", r.targetKinds, "comma-separated kinds of target scopes declarations can be moved to")
+	flags.TextVar(&r.quote, "quote", r.quote, "quote style of variable names in messages (single, backtick or none)")
 }
 
-type analyzeFlags[T ~uint8] []struct {
+type analyzeFlags[T ~uint8 | ~uint16 | ~uint32] []struct {
 	flag        T
 	name, usage string
 }
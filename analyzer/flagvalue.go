@@ -16,7 +16,10 @@
 
 package analyzer
 
-import "strconv"
+import (
+	"strconv"
+	"strings"
+)
 
 type boolValue[F any, B boolFlag[F]] struct {
 	flags B
@@ -75,3 +78,30 @@ func parseBool(str string) (bool, error) {
 
 	return false, &strconv.NumError{Func: "ParseBool", Num: str, Err: strconv.ErrSyntax}
 }
+
+// pragmaList is a comma-separated list of pragma comments.
+type pragmaList []string
+
+// Set implements [flag.Value].
+func (p *pragmaList) Set(s string) error {
+	var pragmas pragmaList
+
+	for pragma := range strings.SplitSeq(s, ",") {
+		if pragma = strings.TrimSpace(pragma); pragma != "" {
+			pragmas = append(pragmas, pragma)
+		}
+	}
+
+	*p = pragmas
+
+	return nil
+}
+
+// String implements [flag.Value].
+func (p *pragmaList) String() string {
+	if p == nil {
+		return ""
+	}
+
+	return strings.Join(*p, ",")
+}
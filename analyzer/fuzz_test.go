@@ -0,0 +1,51 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer_test
+
+import (
+	"errors"
+	"testing"
+
+	. "fillmore-labs.com/scopeguard/analyzer"
+)
+
+func FuzzRun(f *testing.F) {
+	seeds := []string{
+		"package p\n\nfunc _() {\n\tx := 1\n\tif true {\n\t\tprintln(x)\n\t}\n}\n",
+		"package p\n\nfunc _() {\n\tx := struct{ a int }{1}\n\tif true {\n\t\tprintln(x.a)\n\t}\n}\n",
+		"package p\n\nfunc _() { x := 1; if true { println(x) } }\n",
+		"package p\n\ntype t struct{ a int }\n\nfunc _() {\n\tx := t{a: 1}\n\tif x.a > 0 {\n\t\tprintln(x.a)\n\t}\n}\n",
+		"package p\n\nfunc _() {\n\tvar (\n\t\tx = 1\n\t\ty int\n\t)\n\tif true {\n\t\ty = x\n\t\tprintln(y)\n\t}\n}\n",
+		"package p\n\nfunc _() (err error) {\n\tx, err := 1, error(nil)\n\tif err == nil {\n\t\tprintln(x)\n\t}\n\treturn\n}\n",
+		"package p\n\nfunc _() {\n\tfor i := 0; i < 3; i++ {\n\t\ti := i\n\t\tprintln(i)\n\t}\n}\n",
+	}
+
+	for _, seed := range seeds {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, src []byte) {
+		fixed, err := RunSource(src)
+		if errors.Is(err, ErrInvalidSource) {
+			t.Skip()
+		}
+
+		if err != nil {
+			t.Fatalf("%v\n--- input\n%s\n--- fixed\n%s", err, src, fixed)
+		}
+	})
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"go/ast"
+	"go/token"
+
+	"golang.org/x/tools/go/analysis"
+
+	"fillmore-labs.com/scopeguard/internal/report"
+	"fillmore-labs.com/scopeguard/internal/target/check"
+)
+
+// FuncMetrics holds the finding counts of a single analyzed function declaration.
+//
+// Counts are taken before findings are dropped by a baseline or changed lines filter.
+type FuncMetrics struct {
+	// Func is the analyzed function declaration.
+	Func *ast.FuncDecl
+
+	// Position is the position of the function name.
+	Position token.Position
+
+	// Movable is the number of declarations that can be moved, including declarations combined into another one.
+	Movable int
+
+	// Blocked counts declarations in a tighter scope whose move is blocked, by the status code shown in
+	// diagnostics, like "ini" for init field conflicts.
+	Blocked map[string]int
+
+	// Shadows is the number of variables used after being shadowed.
+	Shadows int
+
+	// NestedAssigns is the number of nested assignments.
+	NestedAssigns int
+}
+
+// reportMetrics passes the metrics of an analyzed function to the metrics sink, if set.
+func (r *runOptions) reportMetrics(p *analysis.Pass, fdecl *ast.FuncDecl, diagnostics report.Diagnostics) {
+	if r.metrics == nil {
+		return
+	}
+
+	m := FuncMetrics{
+		Func:          fdecl,
+		Position:      p.Fset.Position(fdecl.Name.Pos()),
+		Blocked:       make(map[string]int),
+		Shadows:       len(diagnostics.Shadows),
+		NestedAssigns: len(diagnostics.Nested),
+	}
+
+	for _, move := range diagnostics.Moves {
+		switch {
+		case move.Status == check.MoveAbsorbed: // Counted with the declaration it is combined into
+
+		case move.Status.Movable():
+			m.Movable += 1 + len(move.AbsorbedDecls)
+
+		default:
+			m.Blocked[move.Status.String()]++
+		}
+	}
+
+	r.metrics(m)
+}
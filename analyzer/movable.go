@@ -0,0 +1,130 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"context"
+	"go/ast"
+	"go/token"
+	"slices"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/edge"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"fillmore-labs.com/scopeguard/internal/astutil"
+	"fillmore-labs.com/scopeguard/internal/config"
+	"fillmore-labs.com/scopeguard/internal/report"
+	"fillmore-labs.com/scopeguard/internal/scope"
+	"fillmore-labs.com/scopeguard/internal/target"
+	"fillmore-labs.com/scopeguard/internal/target/check"
+)
+
+// MoveTarget is a movable variable declaration together with the fix moving it.
+type MoveTarget struct {
+	// Decl is the declaration statement to move.
+	Decl ast.Node
+
+	// TargetNode is the node with the target scope (e.g., *[ast.IfStmt], *[ast.BlockStmt]),
+	// or nil when unused variables are removed.
+	TargetNode ast.Node
+
+	// Fix holds the text edits performing the move, including declarations combined with it.
+	Fix analysis.SuggestedFix
+}
+
+// MovableAt runs the scopeguard pipeline for the function containing pos and returns the move
+// of the declaration at or containing pos, if it can be moved.
+//
+// This is intended for editor integrations offering to tighten the scope of a single declaration:
+// only the enclosing function declaration is analyzed. Declarations combined into another one
+// return the combined move.
+func MovableAt(p *analysis.Pass, in *inspector.Inspector, pos token.Pos, opts ...Option) (*MoveTarget, bool) {
+	c, ok := in.Root().FindByPos(pos, pos)
+	if !ok {
+		return nil, false
+	}
+
+	var (
+		decl, fdecl, file inspector.Cursor
+		hasDecl, hasFunc  bool
+	)
+
+	for c := range c.Enclosing((*ast.AssignStmt)(nil), (*ast.DeclStmt)(nil), (*ast.FuncDecl)(nil), (*ast.File)(nil)) {
+		switch c.Node().(type) {
+		case *ast.File:
+			file = c
+
+		case *ast.FuncDecl:
+			if !hasFunc {
+				fdecl, hasFunc = c, true
+			}
+
+		default:
+			if !hasDecl && !hasFunc {
+				decl, hasDecl = c, true
+			}
+		}
+	}
+
+	if !hasDecl || !hasFunc {
+		return nil, false
+	}
+
+	fn := fdecl.Node().(*ast.FuncDecl)
+	if fn.Body == nil || fn.Doc != nil && astutil.CommentHasNoLint(fn.Doc.List[len(fn.Doc.List)-1]) {
+		return nil, false
+	}
+
+	r := makeRunOptions(opts).forFile(p.Fset, file)
+
+	cf := astutil.NewCurrentFile(p.Fset, file.Node().(*ast.File))
+	if !r.behavior.Enabled(config.IncludeGenerated) && cf.Generated() {
+		return nil, false
+	}
+
+	us, ts := r.stages(p, scope.NewIndex(p.TypesInfo.Scopes))
+
+	ctx := context.Background()
+	body := fdecl.ChildAt(edge.FuncDecl_Body, -1)
+
+	usageData, _ := us.TrackUsage(ctx, body, fn)
+	if !usageData.HasScopeRanges() {
+		return nil, false
+	}
+
+	idx := astutil.NodeIndexOf(decl)
+
+	for _, move := range ts.SelectTargets(ctx, cf, body, usageData) {
+		if move.Status == check.MoveAbsorbed {
+			continue // Reported with the move it is combined into
+		}
+
+		if move.Decl != idx && !slices.ContainsFunc(move.AbsorbedDecls, func(d target.MovableDecl) bool { return d.Decl == idx }) {
+			continue
+		}
+
+		fix, ok := report.SuggestedFix(p, in, move, r.behavior)
+		if !ok {
+			return nil, false
+		}
+
+		return &MoveTarget{Decl: move.Decl.Node(in), TargetNode: move.TargetNode, Fix: fix}, true
+	}
+
+	return nil, false
+}
@@ -18,8 +18,11 @@ package analyzer
 
 import (
 	"log/slog"
+	"slices"
 
 	"fillmore-labs.com/scopeguard/internal/config"
+	"fillmore-labs.com/scopeguard/internal/report"
+	"fillmore-labs.com/scopeguard/internal/scope"
 )
 
 // Option configures specific behavior of a [New] scopeguard analyzer.
@@ -71,6 +74,18 @@ func (o Options) LogAttr() slog.Attr {
 	return slog.Any("options", o)
 }
 
+// strictOption enables all analyzers and [config.StrictBehavior].
+type strictOption struct{}
+
+func (strictOption) apply(r *runOptions) {
+	r.analyzers.Enable(config.StrictAnalyzers)
+	r.behavior.Enable(config.StrictBehavior)
+}
+
+func (strictOption) LogAttr() slog.Attr {
+	return slog.Bool("strict", true)
+}
+
 // WithGenerated is an [Option] to configure diagnostics in generated files.
 func WithGenerated(generated bool) Option { return generatedOption{generated: generated} }
 
@@ -97,6 +112,65 @@ func (o maxLinesOption) LogAttr() slog.Attr {
 	return slog.Int("maxLines", o.maxLines)
 }
 
+// WithMaxAbsorb is an [Option] to configure the maximum number of declarations combined into another one
+// when moving to control flow initializers. Beyond the limit, the declarations are reported without a
+// combined fix. Negative values mean unlimited, which is the default.
+func WithMaxAbsorb(maxAbsorb int) Option { return maxAbsorbOption{maxAbsorb: maxAbsorb} }
+
+type maxAbsorbOption struct{ maxAbsorb int }
+
+func (o maxAbsorbOption) apply(r *runOptions) {
+	r.maxAbsorb = o.maxAbsorb
+}
+
+func (o maxAbsorbOption) LogAttr() slog.Attr {
+	return slog.Int("maxAbsorb", o.maxAbsorb)
+}
+
+// WithMaxWidth is an [Option] to configure the maximum width of a control flow initializer line with combined
+// declarations, counting tabs as one column. Wider combinations are reported without a combined fix.
+// Negative values mean unlimited, which is the default.
+func WithMaxWidth(width int) Option { return maxWidthOption{maxWidth: width} }
+
+type maxWidthOption struct{ maxWidth int }
+
+func (o maxWidthOption) apply(r *runOptions) {
+	r.maxWidth = o.maxWidth
+}
+
+func (o maxWidthOption) LogAttr() slog.Attr {
+	return slog.Int("maxWidth", o.maxWidth)
+}
+
+// WithMaxFuncSize is an [Option] to configure the maximum number of statements of an analyzed function.
+// Larger functions, typically generated code, are skipped with an informational diagnostic (sg:skip),
+// also logging a record with the logger from [WithVerboseSkips]. Negative values mean unlimited, which is the default.
+func WithMaxFuncSize(statements int) Option { return maxFuncSizeOption{maxFuncSize: statements} }
+
+type maxFuncSizeOption struct{ maxFuncSize int }
+
+func (o maxFuncSizeOption) apply(r *runOptions) {
+	r.maxFuncSize = o.maxFuncSize
+}
+
+func (o maxFuncSizeOption) LogAttr() slog.Attr {
+	return slog.Int("maxFuncSize", o.maxFuncSize)
+}
+
+// WithMinSpan is an [Option] to configure the minimum number of lines from a declaration
+// to the end of its usage scope for the declaration to be reported.
+func WithMinSpan(lines int) Option { return minSpanOption{minSpan: lines} }
+
+type minSpanOption struct{ minSpan int }
+
+func (o minSpanOption) apply(r *runOptions) {
+	r.minSpan = o.minSpan
+}
+
+func (o minSpanOption) LogAttr() slog.Attr {
+	return slog.Int("minSpan", o.minSpan)
+}
+
 // WithScope is an [Option] to configure whether scope checks are enabled.
 func WithScope(scope bool) Option {
 	return scopeOption{scope: scope}
@@ -142,6 +216,213 @@ func (o nestedAssignOption) LogAttr() slog.Attr {
 	return slog.Bool("nested-assign", o.nestedAssign)
 }
 
+// WithDeadInit is an [Option] to configure whether dead initial value checks are enabled.
+func WithDeadInit(deadInit bool) Option {
+	return deadInitOption{deadInit: deadInit}
+}
+
+type deadInitOption struct{ deadInit bool }
+
+func (o deadInitOption) apply(r *runOptions) {
+	r.analyzers.Set(config.DeadInitAnalyzer, o.deadInit)
+}
+
+func (o deadInitOption) LogAttr() slog.Attr {
+	return slog.Bool("dead-init", o.deadInit)
+}
+
+// WithLoopShadow is an [Option] to configure whether checks for loop body declarations
+// shadowing for loop variables are enabled.
+func WithLoopShadow(loopShadow bool) Option {
+	return loopShadowOption{loopShadow: loopShadow}
+}
+
+type loopShadowOption struct{ loopShadow bool }
+
+func (o loopShadowOption) apply(r *runOptions) {
+	r.analyzers.Set(config.LoopShadowAnalyzer, o.loopShadow)
+}
+
+func (o loopShadowOption) LogAttr() slog.Attr {
+	return slog.Bool("loop-shadow", o.loopShadow)
+}
+
+// WithRangeShadow is an [Option] to configure whether checks for range loop body declarations shadowing
+// an iteration variable of the same type are enabled.
+func WithRangeShadow(rangeShadow bool) Option {
+	return rangeShadowOption{rangeShadow: rangeShadow}
+}
+
+type rangeShadowOption struct{ rangeShadow bool }
+
+func (o rangeShadowOption) apply(r *runOptions) {
+	r.analyzers.Set(config.RangeShadowAnalyzer, o.rangeShadow)
+}
+
+func (o rangeShadowOption) LogAttr() slog.Attr {
+	return slog.Bool("range-shadow", o.rangeShadow)
+}
+
+// WithBranchInit is an [Option] to configure whether checks for var declarations whose zero values
+// are overwritten on all branches of the following if statement are enabled.
+func WithBranchInit(branchInit bool) Option {
+	return branchInitOption{branchInit: branchInit}
+}
+
+type branchInitOption struct{ branchInit bool }
+
+func (o branchInitOption) apply(r *runOptions) {
+	r.analyzers.Set(config.BranchInitAnalyzer, o.branchInit)
+}
+
+func (o branchInitOption) LogAttr() slog.Attr {
+	return slog.Bool("branch-init", o.branchInit)
+}
+
+// WithLoopLast is an [Option] to configure whether checks for var declarations whose variables
+// only hold the final value assigned in the following loop are enabled.
+func WithLoopLast(loopLast bool) Option {
+	return loopLastOption{loopLast: loopLast}
+}
+
+type loopLastOption struct{ loopLast bool }
+
+func (o loopLastOption) apply(r *runOptions) {
+	r.analyzers.Set(config.LoopLastAnalyzer, o.loopLast)
+}
+
+func (o loopLastOption) LogAttr() slog.Attr {
+	return slog.Bool("loop-last", o.loopLast)
+}
+
+// WithGoCapture is an [Option] to configure whether checks for variables only read inside
+// the function literal of a single go statement are enabled.
+func WithGoCapture(goCapture bool) Option {
+	return goCaptureOption{goCapture: goCapture}
+}
+
+type goCaptureOption struct{ goCapture bool }
+
+func (o goCaptureOption) apply(r *runOptions) {
+	r.analyzers.Set(config.GoCaptureAnalyzer, o.goCapture)
+}
+
+func (o goCaptureOption) LogAttr() slog.Attr {
+	return slog.Bool("go-capture", o.goCapture)
+}
+
+// WithNoopShadow is an [Option] to configure whether checks for short variable declarations
+// redeclaring variables as themselves, like x := x, are enabled.
+func WithNoopShadow(noopShadow bool) Option {
+	return noopShadowOption{noopShadow: noopShadow}
+}
+
+type noopShadowOption struct{ noopShadow bool }
+
+func (o noopShadowOption) apply(r *runOptions) {
+	r.analyzers.Set(config.NoopShadowAnalyzer, o.noopShadow)
+}
+
+func (o noopShadowOption) LogAttr() slog.Attr {
+	return slog.Bool("noop-shadow", o.noopShadow)
+}
+
+// WithJoin is an [Option] to configure whether checks for adjacent short variable declarations
+// that can be joined into a single declaration are enabled.
+func WithJoin(join bool) Option {
+	return joinOption{join: join}
+}
+
+type joinOption struct{ join bool }
+
+func (o joinOption) apply(r *runOptions) {
+	r.analyzers.Set(config.JoinAnalyzer, o.join)
+}
+
+func (o joinOption) LogAttr() slog.Attr {
+	return slog.Bool("join", o.join)
+}
+
+// WithInlineRange is an [Option] to configure whether checks for short variable declarations
+// only used as the range expression of the following loop are enabled.
+func WithInlineRange(inlineRange bool) Option {
+	return inlineRangeOption{inlineRange: inlineRange}
+}
+
+type inlineRangeOption struct{ inlineRange bool }
+
+func (o inlineRangeOption) apply(r *runOptions) {
+	r.analyzers.Set(config.InlineRangeAnalyzer, o.inlineRange)
+}
+
+func (o inlineRangeOption) LogAttr() slog.Attr {
+	return slog.Bool("inline-range", o.inlineRange)
+}
+
+// WithTypeSwitchUnused is an [Option] to configure whether checks for type switch case clauses
+// not reading the variable bound by the switch are enabled.
+func WithTypeSwitchUnused(tsUnused bool) Option {
+	return tsUnusedOption{tsUnused: tsUnused}
+}
+
+type tsUnusedOption struct{ tsUnused bool }
+
+func (o tsUnusedOption) apply(r *runOptions) {
+	r.analyzers.Set(config.TypeSwitchUnusedAnalyzer, o.tsUnused)
+}
+
+func (o tsUnusedOption) LogAttr() slog.Attr {
+	return slog.Bool("ts-unused", o.tsUnused)
+}
+
+// WithCondInline is an [Option] to configure whether checks for if statement initializers
+// whose variable is only used once in the condition are enabled.
+func WithCondInline(condInline bool) Option {
+	return condInlineOption{condInline: condInline}
+}
+
+type condInlineOption struct{ condInline bool }
+
+func (o condInlineOption) apply(r *runOptions) {
+	r.analyzers.Set(config.CondInlineAnalyzer, o.condInline)
+}
+
+func (o condInlineOption) LogAttr() slog.Attr {
+	return slog.Bool("cond-inline", o.condInline)
+}
+
+// WithSwap is an [Option] to configure whether checks for moves blocked in conservative mode
+// by a single statement following the declaration, which can be swapped with it, are enabled.
+func WithSwap(swap bool) Option {
+	return swapOption{swap: swap}
+}
+
+type swapOption struct{ swap bool }
+
+func (o swapOption) apply(r *runOptions) {
+	r.analyzers.Set(config.SwapAnalyzer, o.swap)
+}
+
+func (o swapOption) LogAttr() slog.Attr {
+	return slog.Bool("swap", o.swap)
+}
+
+// WithLoopConst is an [Option] to configure whether checks for never reassigned variables with constant
+// initializers used in for loop conditions, which can be constants, are enabled.
+func WithLoopConst(loopConst bool) Option {
+	return loopConstOption{loopConst: loopConst}
+}
+
+type loopConstOption struct{ loopConst bool }
+
+func (o loopConstOption) apply(r *runOptions) {
+	r.analyzers.Set(config.LoopConstAnalyzer, o.loopConst)
+}
+
+func (o loopConstOption) LogAttr() slog.Attr {
+	return slog.Bool("loop-const", o.loopConst)
+}
+
 // WithConservative is an [Option] to only permit moves without potential side effects.
 func WithConservative(conservative bool) Option {
 	return conservativeOption{conservative: conservative}
@@ -170,6 +451,347 @@ func (o combineOption) LogAttr() slog.Attr {
 	return slog.Bool("combine", o.combine)
 }
 
+// WithGroupRelated is an [Option] to report combined declarations in a single diagnostic.
+func WithGroupRelated(group bool) Option { return groupRelatedOption{group: group} }
+
+type groupRelatedOption struct{ group bool }
+
+func (o groupRelatedOption) apply(r *runOptions) {
+	r.behavior.Set(config.GroupRelated, o.group)
+}
+
+func (o groupRelatedOption) LogAttr() slog.Attr {
+	return slog.Bool("group-related", o.group)
+}
+
+// WithLoopBodyMoves is an [Option] to permit moving loop invariant declarations into loop bodies.
+//
+// This requires Go 1.22 or later, where for loops create a fresh variable per iteration.
+func WithLoopBodyMoves(loopBody bool) Option { return loopBodyMovesOption{loopBody: loopBody} }
+
+type loopBodyMovesOption struct{ loopBody bool }
+
+func (o loopBodyMovesOption) apply(r *runOptions) {
+	r.behavior.Set(config.LoopBodyMoves, o.loopBody)
+}
+
+func (o loopBodyMovesOption) LogAttr() slog.Attr {
+	return slog.Bool("loop-body", o.loopBody)
+}
+
+// WithColor is an [Option] to highlight variable names, scope kinds and status codes
+// in diagnostic messages with ANSI escape sequences, intended for interactive terminal use.
+func WithColor(color bool) Option { return colorOption{color: color} }
+
+type colorOption struct{ color bool }
+
+func (o colorOption) apply(r *runOptions) {
+	r.behavior.Set(config.Color, o.color)
+}
+
+func (o colorOption) LogAttr() slog.Attr {
+	return slog.Bool("color", o.color)
+}
+
+// WithReportAtTarget is an [Option] to report movable declarations at the target scope
+// instead of the declaration, which is then given as related information.
+func WithReportAtTarget(atTarget bool) Option { return reportAtTargetOption{atTarget: atTarget} }
+
+type reportAtTargetOption struct{ atTarget bool }
+
+func (o reportAtTargetOption) apply(r *runOptions) {
+	r.behavior.Set(config.ReportAtTarget, o.atTarget)
+}
+
+func (o reportAtTargetOption) LogAttr() slog.Attr {
+	return slog.Bool("report-at-target", o.atTarget)
+}
+
+// WithSimplify is an [Option] to rewrite var declarations moved to a block as short variable declarations
+// when the explicit type matches the type of the initial value.
+func WithSimplify(simplify bool) Option { return simplifyOption{simplify: simplify} }
+
+type simplifyOption struct{ simplify bool }
+
+func (o simplifyOption) apply(r *runOptions) {
+	r.behavior.Set(config.SimplifyDeclarations, o.simplify)
+}
+
+func (o simplifyOption) LogAttr() slog.Attr {
+	return slog.Bool("simplify", o.simplify)
+}
+
+// WithPreferBlock is an [Option] to move short variable declarations to blocks only,
+// never into control flow initializers like if statement init fields.
+func WithPreferBlock(preferBlock bool) Option { return preferBlockOption{preferBlock: preferBlock} }
+
+type preferBlockOption struct{ preferBlock bool }
+
+func (o preferBlockOption) apply(r *runOptions) {
+	r.behavior.Set(config.PreferBlock, o.preferBlock)
+}
+
+func (o preferBlockOption) LogAttr() slog.Attr {
+	return slog.Bool("prefer-block", o.preferBlock)
+}
+
+// WithKeepDocumentedDeclarations is an [Option] to report moves of var declarations with doc comments
+// without suggested fixes, respecting the author's intent of a prominent declaration.
+func WithKeepDocumentedDeclarations(keep bool) Option { return keepDocumentedOption{keep: keep} }
+
+type keepDocumentedOption struct{ keep bool }
+
+func (o keepDocumentedOption) apply(r *runOptions) {
+	r.behavior.Set(config.KeepDocumented, o.keep)
+}
+
+func (o keepDocumentedOption) LogAttr() slog.Attr {
+	return slog.Bool("keep-documented", o.keep)
+}
+
+// WithSkipDocumentedDeclarations is an [Option] to not report moves of var declarations with doc comments at all.
+func WithSkipDocumentedDeclarations(skip bool) Option { return skipDocumentedOption{skip: skip} }
+
+type skipDocumentedOption struct{ skip bool }
+
+func (o skipDocumentedOption) apply(r *runOptions) {
+	r.behavior.Set(config.SkipDocumented, o.skip)
+}
+
+func (o skipDocumentedOption) LogAttr() slog.Attr {
+	return slog.Bool("skip-documented", o.skip)
+}
+
+// WithFixComment is an [Option] to precede declarations moved to blocks with a comment naming scopeguard and
+// its version, like "// scopeguard <version>: tightened scope", for auditability. Moves to control flow initializers
+// get no comment.
+func WithFixComment(comment bool) Option { return fixCommentOption{comment: comment} }
+
+type fixCommentOption struct{ comment bool }
+
+func (o fixCommentOption) apply(r *runOptions) {
+	r.behavior.Set(config.FixComment, o.comment)
+}
+
+func (o fixCommentOption) LogAttr() slog.Attr {
+	return slog.Bool("fix-comment", o.comment)
+}
+
+// WithIndentFix is an [Option] to indent declarations moved to blocks or clauses like the statements of the
+// target block, so suggested fixes applied without a following gofmt produce correctly indented code.
+// Statements wrapped in blocks introduced with [WithIntroduceBlocks] are indented, too.
+func WithIndentFix(indent bool) Option { return indentFixOption{indent: indent} }
+
+type indentFixOption struct{ indent bool }
+
+func (o indentFixOption) apply(r *runOptions) {
+	r.behavior.Set(config.IndentFix, o.indent)
+}
+
+func (o indentFixOption) LogAttr() slog.Attr {
+	return slog.Bool("indent-fix", o.indent)
+}
+
+// WithExplainUnused is an [Option] to add related information to diagnostics of unused variables, explaining
+// whether they are reassigned before being read or become unused after moving another declaration.
+func WithExplainUnused(explainUnused bool) Option {
+	return explainUnusedOption{explainUnused: explainUnused}
+}
+
+type explainUnusedOption struct{ explainUnused bool }
+
+func (o explainUnusedOption) apply(r *runOptions) {
+	r.behavior.Set(config.ExplainUnused, o.explainUnused)
+}
+
+func (o explainUnusedOption) LogAttr() slog.Attr {
+	return slog.Bool("explain-unused", o.explainUnused)
+}
+
+// ScopeKind is a set of target scope kinds for [WithTargetKinds].
+type ScopeKind = scope.Kind
+
+// Target scope kinds for [WithTargetKinds].
+const (
+	IfInit         = scope.IfInit         // Init field of an if statement
+	ForInit        = scope.ForInit        // Init field of a for statement
+	SwitchInit     = scope.SwitchInit     // Init field of a switch statement
+	TypeSwitchInit = scope.TypeSwitchInit // Init field of a type switch statement
+	Block          = scope.Block          // Block statement, like the body of an if statement
+	Case           = scope.Case           // Case clause of a switch or type switch statement
+	CommClause     = scope.CommClause     // Case clause of a select statement
+)
+
+// WithKeepPragma is an [Option] to keep declarations followed by one of the pragma comments on their line
+// at their scope, for example:
+//
+//	WithKeepPragma("keep:top")
+//
+// keeps
+//
+//	mu := &sync.Mutex{} //keep:top
+//
+// at function scope. Pragmas may be followed by an explanation. This self-documents intent and is more discoverable
+// than nolint directives.
+func WithKeepPragma(pragmas ...string) Option {
+	return keepPragmaOption{pragmas: slices.Clone(pragmas)}
+}
+
+type keepPragmaOption struct{ pragmas []string }
+
+func (o keepPragmaOption) apply(r *runOptions) {
+	r.keepPragmas = o.pragmas
+}
+
+func (o keepPragmaOption) LogAttr() slog.Attr {
+	return slog.Any("keep-pragma", o.pragmas)
+}
+
+// WithTargetKinds is an [Option] to restrict the kinds of target scopes declarations can be moved to.
+// All kinds are eligible by default; unknown kinds are ignored.
+//
+// For example, to only move declarations into init fields:
+//
+//	WithTargetKinds(IfInit, ForInit, SwitchInit, TypeSwitchInit)
+func WithTargetKinds(kinds ...ScopeKind) Option {
+	var set ScopeKind
+	for _, kind := range kinds {
+		set |= kind
+	}
+
+	return targetKindsOption{kinds: set & scope.AllKinds}
+}
+
+type targetKindsOption struct{ kinds ScopeKind }
+
+func (o targetKindsOption) apply(r *runOptions) {
+	r.targetKinds = o.kinds
+}
+
+func (o targetKindsOption) LogAttr() slog.Attr {
+	return slog.String("target-kinds", o.kinds.String())
+}
+
+// WithOnlyErrorVars is an [Option] to only report moves of declarations assigning a variable named err
+// or implementing error. Other diagnostics are unaffected.
+func WithOnlyErrorVars(onlyErrors bool) Option { return onlyErrorVarsOption{onlyErrors: onlyErrors} }
+
+type onlyErrorVarsOption struct{ onlyErrors bool }
+
+func (o onlyErrorVarsOption) apply(r *runOptions) {
+	r.behavior.Set(config.OnlyErrorVars, o.onlyErrors)
+}
+
+func (o onlyErrorVarsOption) LogAttr() slog.Attr {
+	return slog.Bool("only-errors", o.onlyErrors)
+}
+
+// WithPerfHints is an [Option] to mention in move diagnostics when the target scope is only conditionally executed,
+// so a call in the initializer is only evaluated when needed.
+func WithPerfHints(perfHints bool) Option { return perfHintsOption{perfHints: perfHints} }
+
+type perfHintsOption struct{ perfHints bool }
+
+func (o perfHintsOption) apply(r *runOptions) {
+	r.behavior.Set(config.PerfHints, o.perfHints)
+}
+
+func (o perfHintsOption) LogAttr() slog.Attr {
+	return slog.Bool("perf-hints", o.perfHints)
+}
+
+// WithAddressHints is an [Option] to mention in move diagnostics when the address of a moved variable is taken,
+// since a tighter scope can help escape analysis.
+func WithAddressHints(addressHints bool) Option {
+	return addressHintsOption{addressHints: addressHints}
+}
+
+type addressHintsOption struct{ addressHints bool }
+
+func (o addressHintsOption) apply(r *runOptions) {
+	r.behavior.Set(config.AddressHints, o.addressHints)
+}
+
+func (o addressHintsOption) LogAttr() slog.Attr {
+	return slog.Bool("address-hints", o.addressHints)
+}
+
+// WithIntroduceBlocks is an [Option] to report declarations whose variables are only used by the statements
+// directly following them, suggesting to wrap these statements in a new block ending before later code of the
+// same list.
+func WithIntroduceBlocks(introduce bool) Option { return introduceBlocksOption{introduce: introduce} }
+
+type introduceBlocksOption struct{ introduce bool }
+
+func (o introduceBlocksOption) apply(r *runOptions) {
+	r.behavior.Set(config.IntroduceBlocks, o.introduce)
+}
+
+func (o introduceBlocksOption) LogAttr() slog.Attr {
+	return slog.Bool("introduce-blocks", o.introduce)
+}
+
+// WithIgnoreDebugPrints is an [Option] to disregard uses as direct arguments of fmt.Print*, log.Print*
+// and the print and println builtins when computing the tightest scope of a variable.
+//
+// This changes semantics if the prints stay: such moves are reported as blocked and carry no suggested fix,
+// since they are only valid once the debug prints are removed.
+func WithIgnoreDebugPrints(ignore bool) Option { return ignoreDebugPrintsOption{ignore: ignore} }
+
+type ignoreDebugPrintsOption struct{ ignore bool }
+
+func (o ignoreDebugPrintsOption) apply(r *runOptions) {
+	r.behavior.Set(config.IgnoreDebugPrints, o.ignore)
+}
+
+func (o ignoreDebugPrintsOption) LogAttr() slog.Attr {
+	return slog.Bool("ignore-debug-prints", o.ignore)
+}
+
+// WithTargetSnippets is an [Option] to quote the first line of the target scope, truncated,
+// in the related information of move diagnostics, like "To this if scope: `if cond {`".
+func WithTargetSnippets(snippets bool) Option { return targetSnippetsOption{snippets: snippets} }
+
+type targetSnippetsOption struct{ snippets bool }
+
+func (o targetSnippetsOption) apply(r *runOptions) {
+	r.behavior.Set(config.TargetSnippets, o.snippets)
+}
+
+func (o targetSnippetsOption) LogAttr() slog.Attr {
+	return slog.Bool("target-snippets", o.snippets)
+}
+
+// WithAnalyzeClosures is an [Option] to track declarations inside function literals, enabled by default.
+//
+// Disabling it trades the findings for closure-local variables for speed on code with huge closures.
+// Uses of outer variables inside function literals are still tracked, so findings for them are unaffected.
+func WithAnalyzeClosures(closures bool) Option { return analyzeClosuresOption{closures: closures} }
+
+type analyzeClosuresOption struct{ closures bool }
+
+func (o analyzeClosuresOption) apply(r *runOptions) {
+	r.behavior.Set(config.AnalyzeClosures, o.closures)
+}
+
+func (o analyzeClosuresOption) LogAttr() slog.Attr {
+	return slog.Bool("closures", o.closures)
+}
+
+// WithParallel is an [Option] to analyze the files of a package concurrently, bounded by GOMAXPROCS.
+// Diagnostics are reported in file order after all files are analyzed.
+func WithParallel(parallel bool) Option { return parallelOption{parallel: parallel} }
+
+type parallelOption struct{ parallel bool }
+
+func (o parallelOption) apply(r *runOptions) {
+	r.behavior.Set(config.ParallelFiles, o.parallel)
+}
+
+func (o parallelOption) LogAttr() slog.Attr {
+	return slog.Bool("parallel", o.parallel)
+}
+
 // WithRename is an [Option] to configure renaming shadowed variables.
 func WithRename(rename bool) Option { return renameOption{rename: rename} }
 
@@ -182,3 +804,190 @@ func (o renameOption) apply(r *runOptions) {
 func (o renameOption) LogAttr() slog.Attr {
 	return slog.Bool("rename", o.rename)
 }
+
+// WithRenameGenerated is an [Option] to configure renaming shadowed variables in generated files.
+// It only has an effect together with [WithRename] and [WithGenerated], for generated code that is edited by hand.
+func WithRenameGenerated(renameGenerated bool) Option {
+	return renameGeneratedOption{renameGenerated: renameGenerated}
+}
+
+type renameGeneratedOption struct{ renameGenerated bool }
+
+func (o renameGeneratedOption) apply(r *runOptions) {
+	r.behavior.Set(config.RenameGenerated, o.renameGenerated)
+}
+
+func (o renameGeneratedOption) LogAttr() slog.Attr {
+	return slog.Bool("rename-generated", o.renameGenerated)
+}
+
+// WithRenameLimit is an [Option] to configure the maximum number of suffixes tried when renaming
+// a shadowed variable. When exhausted, the shadow diagnostic is reported without a rename fix,
+// and a debug record is logged with the logger from [WithVerboseSkips].
+func WithRenameLimit(limit int) Option { return renameLimitOption{limit: limit} }
+
+type renameLimitOption struct{ limit int }
+
+func (o renameLimitOption) apply(r *runOptions) {
+	r.renameLimit = o.limit
+}
+
+func (o renameLimitOption) LogAttr() slog.Attr {
+	return slog.Int("renameLimit", o.limit)
+}
+
+// WithBaseline is an [Option] to configure a file of accepted findings that are not reported.
+//
+// Each line holds a file:line:name entry naming a variable of a finding; empty lines and lines
+// starting with '#' are ignored. Entries match findings in files with the path suffix for the same
+// variable within a few lines, so minor edits don't invalidate the baseline. The file is read once
+// on the first run, an empty path disables the baseline.
+func WithBaseline(path string) Option { return baselineOption{path: path} }
+
+type baselineOption struct{ path string }
+
+func (o baselineOption) apply(r *runOptions) {
+	r.baselineFile = o.path
+}
+
+func (o baselineOption) LogAttr() slog.Attr {
+	return slog.String("baseline", o.path)
+}
+
+// LineRange is an inclusive range of lines, starting at 1.
+type LineRange = report.LineRange
+
+// WithChangedLines is an [Option] to only report findings whose declaration is within the given line ranges,
+// keyed by file paths. Keys match files with the path suffix, so paths relative to the repository root,
+// as reported by git diff, can be used. Functions are still analyzed as a whole.
+//
+// This gives fast feedback on just-edited code, e.g. in pre-commit hooks. A nil map reports all findings.
+func WithChangedLines(lines map[string][]LineRange) Option { return changedLinesOption{lines: lines} }
+
+type changedLinesOption struct{ lines map[string][]LineRange }
+
+func (o changedLinesOption) apply(r *runOptions) {
+	r.changedLines = o.lines
+}
+
+func (o changedLinesOption) LogAttr() slog.Attr {
+	return slog.Int("changedFiles", len(o.lines))
+}
+
+// WithReportCategory is an [Option] to set the category of all reported diagnostics, "scopeguard" by default.
+//
+// This lets tools aggregating findings of multiple linters filter them without matching messages.
+// An empty category leaves diagnostics uncategorized.
+func WithReportCategory(category string) Option { return reportCategoryOption{category: category} }
+
+type reportCategoryOption struct{ category string }
+
+func (o reportCategoryOption) apply(r *runOptions) {
+	r.category = o.category
+}
+
+func (o reportCategoryOption) LogAttr() slog.Attr {
+	return slog.String("category", o.category)
+}
+
+// QuoteStyle selects how variable names are quoted in diagnostic messages.
+type QuoteStyle = report.QuoteStyle
+
+// Quote styles for [WithQuoteStyle].
+const (
+	QuoteSingle   = report.QuoteSingle   // 'x', the default
+	QuoteBacktick = report.QuoteBacktick // `x`, rendered as code by Markdown viewers
+	QuoteNone     = report.QuoteNone     // x
+)
+
+// WithQuoteStyle is an [Option] to set how variable names are quoted in diagnostic messages.
+func WithQuoteStyle(quote QuoteStyle) Option { return quoteStyleOption{quote: quote} }
+
+type quoteStyleOption struct{ quote QuoteStyle }
+
+func (o quoteStyleOption) apply(r *runOptions) {
+	r.quote = o.quote
+}
+
+func (o quoteStyleOption) LogAttr() slog.Attr {
+	return slog.String("quote", o.quote.String())
+}
+
+// WithTestBehavior is an [Option] to apply additional options to test files, those ending in _test.go.
+//
+// This permits, e.g., disabling moves in tests while keeping shadow detection everywhere:
+//
+//	WithTestBehavior(Options{WithScope(false)})
+//
+// Options affecting the whole pass, like [WithParallel], [WithBaseline], [WithChangedLines] and [WithReportCategory],
+// are taken from the main options.
+func WithTestBehavior(opts Options) Option { return testBehaviorOption{opts: opts} }
+
+type testBehaviorOption struct{ opts Options }
+
+func (o testBehaviorOption) apply(r *runOptions) {
+	r.testOptions = append(r.testOptions, o.opts...)
+}
+
+func (o testBehaviorOption) LogAttr() slog.Attr {
+	return slog.Any("test", o.opts)
+}
+
+// WithReportOnly is an [Option] to report diagnostics without suggested fixes.
+func WithReportOnly(reportOnly bool) Option { return reportOnlyOption{reportOnly: reportOnly} }
+
+type reportOnlyOption struct{ reportOnly bool }
+
+func (o reportOnlyOption) apply(r *runOptions) {
+	r.behavior.Set(config.ReportOnly, o.reportOnly)
+}
+
+func (o reportOnlyOption) LogAttr() slog.Attr {
+	return slog.Bool("report-only", o.reportOnly)
+}
+
+// WithReportBlocked is an [Option] to report blocked moves even in conservative mode, adding the reason blocking
+// the move as related information. Blocked moves carry no suggested fix.
+func WithReportBlocked(reportBlocked bool) Option {
+	return reportBlockedOption{reportBlocked: reportBlocked}
+}
+
+type reportBlockedOption struct{ reportBlocked bool }
+
+func (o reportBlockedOption) apply(r *runOptions) {
+	r.behavior.Set(config.ReportBlocked, o.reportBlocked)
+}
+
+func (o reportBlockedOption) LogAttr() slog.Attr {
+	return slog.Bool("report-blocked", o.reportBlocked)
+}
+
+// WithMetrics is an [Option] to pass the finding counts of each analyzed function to sink,
+// for example to track code quality over time without parsing diagnostics.
+//
+// With [WithParallel], sink is called concurrently from several goroutines.
+func WithMetrics(sink func(FuncMetrics)) Option { return metricsOption{sink: sink} }
+
+type metricsOption struct{ sink func(FuncMetrics) }
+
+func (o metricsOption) apply(r *runOptions) {
+	r.metrics = o.sink
+}
+
+func (o metricsOption) LogAttr() slog.Attr {
+	return slog.Bool("metrics", o.sink != nil)
+}
+
+// WithVerboseSkips is an [Option] to log each declaration the analyzer chose not to consider, and why.
+// This helps to understand why an expected diagnostic didn't appear. A nil logger disables logging.
+func WithVerboseSkips(logger *slog.Logger) Option { return verboseSkipsOption{logger: logger} }
+
+type verboseSkipsOption struct{ logger *slog.Logger }
+
+func (o verboseSkipsOption) apply(r *runOptions) {
+	r.logger = o.logger
+}
+
+func (o verboseSkipsOption) LogAttr() slog.Attr {
+	return slog.Bool("verbose-skips", o.logger != nil)
+}
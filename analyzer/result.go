@@ -0,0 +1,204 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"cmp"
+	"go/ast"
+	"go/types"
+	"iter"
+	"maps"
+	"slices"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"fillmore-labs.com/scopeguard/internal/config"
+	"fillmore-labs.com/scopeguard/internal/report"
+	"fillmore-labs.com/scopeguard/internal/scope"
+	"fillmore-labs.com/scopeguard/internal/target"
+	"fillmore-labs.com/scopeguard/internal/target/check"
+	"fillmore-labs.com/scopeguard/internal/usage"
+)
+
+// ScopeRange describes the computed scopes of a variable declaration.
+type ScopeRange struct {
+	// Decl is the declaring statement or specification.
+	Decl ast.Node
+
+	// DeclScope is the scope where the variables are declared.
+	DeclScope *types.Scope
+
+	// UsageScope is the tightest scope containing all uses of the declared variables.
+	UsageScope *types.Scope
+}
+
+// MoveInfo describes the move of a variable's declaration found during the analysis.
+type MoveInfo struct {
+	// Decl is the declaration statement to move.
+	Decl ast.Node
+
+	// TargetNode is the node with the target scope (e.g., *[ast.IfStmt], *[ast.BlockStmt]),
+	// or nil when the variable is removed.
+	TargetNode ast.Node
+
+	// Kind is the kind of the target scope, or zero when the variable is removed.
+	Kind ScopeKind
+
+	// Unused reports whether the variable is unused and replaced with the blank identifier or removed.
+	Unused bool
+
+	// Fixable reports whether a suggested fix moving the declaration is available.
+	Fixable bool
+}
+
+// Result is the result of the scopeguard analyzer for a package.
+//
+// It provides read-only access to the scope ranges and moves computed during the analysis,
+// for example to visualize variable lifetimes or to offer moves on hover in editors.
+type Result struct {
+	scopeRanges []ScopeRange
+	moves       map[*types.Var]MoveInfo
+}
+
+// AllScopeRanges returns the scope ranges of all tracked declarations in source order.
+func (r *Result) AllScopeRanges() iter.Seq[ScopeRange] {
+	if r == nil {
+		return func(func(ScopeRange) bool) {}
+	}
+
+	return slices.Values(r.scopeRanges)
+}
+
+// QueryVar returns the move of the declaration of v, if one was found.
+//
+// Moves are recorded regardless of diagnostics suppressed by baselines, changed lines or nolint comments.
+func (r *Result) QueryVar(v *types.Var) (MoveInfo, bool) {
+	if r == nil {
+		return MoveInfo{}, false
+	}
+
+	info, ok := r.moves[v]
+
+	return info, ok
+}
+
+// QueryVar returns the move of the declaration of v from the scopeguard result of a pass,
+// which requires a scopeguard analyzer.
+func QueryVar(p *analysis.Pass, v *types.Var) (MoveInfo, bool) {
+	for _, res := range p.ResultOf {
+		if r, ok := res.(*Result); ok {
+			return r.QueryVar(v)
+		}
+	}
+
+	return MoveInfo{}, false
+}
+
+// addMoves records the moves of a function by their declared variables.
+//
+// Moves are fixable when the report stage creates a suggested fix for them.
+func (r *Result) addMoves(p *analysis.Pass, in *inspector.Inspector, moves []target.MoveTarget, option config.BitMask[config.Config]) {
+	for _, move := range moves {
+		if move.Status == check.MoveAbsorbed {
+			continue // Recorded with the move it is combined into
+		}
+
+		fixable := false
+		if !option.Enabled(config.ReportOnly) {
+			_, fixable = report.SuggestedFix(p, in, move, option)
+		}
+
+		decls := append([]target.MovableDecl{move.MovableDecl}, move.AbsorbedDecls...)
+		for _, decl := range decls {
+			for v := range declaredVars(p.TypesInfo, decl.Decl.Node(in)) {
+				if r.moves == nil {
+					r.moves = make(map[*types.Var]MoveInfo)
+				}
+
+				r.moves[v] = MoveInfo{
+					Decl:       decl.Decl.Node(in),
+					TargetNode: move.TargetNode,
+					Kind:       scope.KindOf(move.TargetNode),
+					Unused:     slices.Contains(decl.Unused, v.Name()),
+					Fixable:    fixable,
+				}
+			}
+		}
+	}
+}
+
+// merge adds the scope ranges and moves of another result.
+func (r *Result) merge(other *Result) {
+	r.scopeRanges = append(r.scopeRanges, other.scopeRanges...)
+
+	if len(other.moves) > 0 {
+		if r.moves == nil {
+			r.moves = make(map[*types.Var]MoveInfo, len(other.moves))
+		}
+
+		maps.Copy(r.moves, other.moves)
+	}
+}
+
+// declaredVars returns the variables declared by a short variable or var declaration.
+func declaredVars(info *types.Info, stmt ast.Node) iter.Seq[*types.Var] {
+	var ids []*ast.Ident
+
+	switch n := stmt.(type) {
+	case *ast.AssignStmt:
+		for _, expr := range n.Lhs {
+			if id, ok := expr.(*ast.Ident); ok {
+				ids = append(ids, id)
+			}
+		}
+
+	case *ast.DeclStmt:
+		if gen, ok := n.Decl.(*ast.GenDecl); ok {
+			for _, spec := range gen.Specs {
+				if vspec, ok := spec.(*ast.ValueSpec); ok {
+					ids = append(ids, vspec.Names...)
+				}
+			}
+		}
+	}
+
+	return func(yield func(*types.Var) bool) {
+		for _, id := range ids {
+			if v, ok := info.Defs[id].(*types.Var); ok && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// appendScopeRanges appends the scope ranges of a function's usage data in source order.
+func appendScopeRanges(ranges []ScopeRange, in *inspector.Inspector, usageData usage.Result) []ScopeRange {
+	start := len(ranges)
+
+	for decl, scopeRange := range usageData.AllScopeRanges() {
+		ranges = append(ranges, ScopeRange{
+			Decl:       decl.Node(in),
+			DeclScope:  scopeRange.Decl,
+			UsageScope: scopeRange.Usage,
+		})
+	}
+
+	slices.SortFunc(ranges[start:], func(a, b ScopeRange) int { return cmp.Compare(a.Decl.Pos(), b.Decl.Pos()) })
+
+	return ranges
+}
@@ -21,7 +21,12 @@ import (
 	"errors"
 	"fmt"
 	"go/ast"
+	"go/token"
+	"log/slog"
+	"runtime"
 	"runtime/trace"
+	"strings"
+	"sync"
 
 	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/analysis/passes/inspect"
@@ -49,91 +54,367 @@ func (r *runOptions) run(p *analysis.Pass) (any, error) {
 		return nil, fmt.Errorf("scopeguard: %s %w", inspect.Analyzer.Name, ErrResultMissing)
 	}
 
+	return r.runInspector(p, in)
+}
+
+// RunWithInspector executes the scopeguard analyzer's pipeline with the provided [inspector.Inspector].
+//
+// The returned result is a *[Result], so the calling analyzer has to declare it as its ResultType.
+//
+// This is useful for embedding scopeguard into custom drivers that already have an inspector
+// for the pass files, since it does not require the [inspect.Analyzer] result.
+func (o Options) RunWithInspector(p *analysis.Pass, in *inspector.Inspector) (any, error) {
+	return makeRunOptions(o).runInspector(p, in)
+}
+
+// stages configures the usage and target stages of the pipeline for a pass with a scope index.
+func (r *runOptions) stages(p *analysis.Pass, scopes scope.Index) (usage.Stage, target.Stage) {
+	us := usage.Stage{
+		Pass:              p,
+		UsageScope:        scope.NewUsageScope(scopes),
+		Analyzers:         r.analyzers,
+		IgnoreDebugPrints: r.behavior.Enabled(config.IgnoreDebugPrints),
+		SkipClosures:      !r.behavior.Enabled(config.AnalyzeClosures),
+	}
+
+	ts := target.Stage{
+		Pass:           p,
+		TargetScope:    scope.NewTargetScope(scopes),
+		MaxLines:       r.maxLines,
+		MinSpan:        r.minSpan,
+		Conservative:   r.behavior.Enabled(config.Conservative),
+		Combine:        r.behavior.Enabled(config.CombineDeclarations),
+		MaxAbsorb:      r.maxAbsorb,
+		MaxWidth:       r.maxWidth,
+		GroupRelated:   r.behavior.Enabled(config.GroupRelated),
+		LoopBodyMoves:  r.behavior.Enabled(config.LoopBodyMoves),
+		PreferBlock:    r.behavior.Enabled(config.PreferBlock),
+		TargetKinds:    r.targetKinds,
+		OnlyErrorVars:  r.behavior.Enabled(config.OnlyErrorVars),
+		KeepDocumented: r.behavior.Enabled(config.KeepDocumented),
+		SkipDocumented: r.behavior.Enabled(config.SkipDocumented),
+		ExplainUnused:  r.behavior.Enabled(config.ExplainUnused),
+		AddressHints:   r.behavior.Enabled(config.AddressHints),
+		KeepPragmas:    r.keepPragmas,
+		Logger:         r.logger,
+	}
+
+	return us, ts
+}
+
+// runInspector executes the scopeguard analyzer's pipeline using the given [inspector.Inspector].
+//
+// With [config.ParallelFiles], files are analyzed concurrently and their diagnostics are reported in file order.
+func (r *runOptions) runInspector(p *analysis.Pass, in *inspector.Inspector) (any, error) {
 	ctx := context.Background()
 
 	ctx, task := trace.NewTask(ctx, "ScopeGuard")
 	defer task.End()
 
+	baseline, err := r.baseline()
+	if err != nil {
+		return nil, err
+	}
+
+	p = withCategory(p, r.category)
+
 	// Build inverted scope->node map for bidirectional AST/scope navigation
 	scopes := scope.NewIndex(p.TypesInfo.Scopes)
 
-	us := usage.Stage{
-		Pass:       p,
-		UsageScope: scope.NewUsageScope(scopes),
-		Analyzers:  r.analyzers,
+	runs := r.fileRuns(p, scopes)
+	filter := report.Filter{Baseline: baseline, Lines: r.changedLines}
+
+	var files []inspector.Cursor
+	for file := range in.Root().Children() {
+		files = append(files, file)
 	}
 
-	ts := target.Stage{
-		Pass:         p,
-		TargetScope:  scope.NewTargetScope(scopes),
-		MaxLines:     r.maxLines,
-		Conservative: r.behavior.Enabled(config.Conservative),
-		Combine:      r.behavior.Enabled(config.CombineDeclarations),
+	result := &Result{}
+
+	if !r.behavior.Enabled(config.ParallelFiles) || len(files) < 2 {
+		for _, file := range files {
+			fr := runs.forFile(p.Fset, file)
+			fr.runFile(ctx, p, filter, file, result)
+		}
+
+		return result, nil
+	}
+
+	// Each file gets a copy of the pass collecting its diagnostics.
+	diagnostics := make([][]analysis.Diagnostic, len(files))
+	results := make([]Result, len(files))
+
+	var (
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, runtime.GOMAXPROCS(0))
+	)
+
+	for idx, file := range files {
+		wg.Add(1)
+
+		sem <- struct{}{}
+
+		go func() {
+			defer func() { <-sem; wg.Done() }()
+
+			fp := *p
+			fp.Report = func(d analysis.Diagnostic) { diagnostics[idx] = append(diagnostics[idx], d) }
+
+			fr := runs.forFile(p.Fset, file)
+			fr.us.Pass, fr.ts.Pass = &fp, &fp
+
+			fr.runFile(ctx, &fp, filter, file, &results[idx])
+		}()
+	}
+
+	wg.Wait()
+
+	for idx, fileDiagnostics := range diagnostics {
+		for _, d := range fileDiagnostics {
+			p.Report(d)
+		}
+
+		result.merge(&results[idx])
+	}
+
+	return result, nil
+}
+
+// withCategory returns a copy of the pass setting the category of reported diagnostics without one.
+func withCategory(p *analysis.Pass, category string) *analysis.Pass {
+	if category == "" {
+		return p
+	}
+
+	cp := *p
+	cp.Report = func(d analysis.Diagnostic) {
+		if d.Category == "" {
+			d.Category = category
+		}
+
+		p.Report(d)
+	}
+
+	return &cp
+}
+
+// fileRun holds the configured pipeline stages for analyzing files.
+type fileRun struct {
+	*runOptions
+	us           usage.Stage
+	ts           target.Stage
+	renameConfig report.RenameConfig
+}
+
+// fileRuns holds the pipelines for production and test files.
+type fileRuns struct {
+	main, test fileRun
+}
+
+// fileRuns configures the pipelines of a pass, using the test options for test files if set.
+func (r *runOptions) fileRuns(p *analysis.Pass, scopes scope.Index) fileRuns {
+	main := r.fileRun(p, scopes)
+	if r.test == nil {
+		return fileRuns{main: main, test: main}
+	}
+
+	return fileRuns{main: main, test: r.test.fileRun(p, scopes)}
+}
+
+// fileRun configures the pipeline of a pass.
+func (r *runOptions) fileRun(p *analysis.Pass, scopes scope.Index) fileRun {
+	us, ts := r.stages(p, scopes)
+
+	return fileRun{
+		runOptions:   r,
+		us:           us,
+		ts:           ts,
+		renameConfig: report.RenameConfig{Limit: r.renameLimit, Logger: r.logger},
+	}
+}
+
+// forFile returns the pipeline for a file.
+func (f fileRuns) forFile(fset *token.FileSet, file inspector.Cursor) fileRun {
+	if testFile(fset, file) {
+		return f.test
+	}
+
+	return f.main
+}
+
+// forFile returns the options for a file, which are the test options for test files, if set.
+func (r *runOptions) forFile(fset *token.FileSet, file inspector.Cursor) *runOptions {
+	if r.test != nil && testFile(fset, file) {
+		return r.test
+	}
+
+	return r
+}
+
+// testFile reports whether the file is a test file, selected by its name.
+func testFile(fset *token.FileSet, file inspector.Cursor) bool {
+	tf := fset.File(file.Node().Pos())
+
+	return tf != nil && strings.HasSuffix(tf.Name(), "_test.go")
+}
+
+// runFile analyzes all function and method declarations of a single file.
+//
+// It adds the scope ranges and moves of the file's declarations to result.
+func (r fileRun) runFile(ctx context.Context, p *analysis.Pass, filter report.Filter, file inspector.Cursor, result *Result) {
+	us, ts := r.us, r.ts
+
+	node, ok := file.Node().(*ast.File)
+	if !ok {
+		astutil.InternalError(p, file.Node(), "Unexpected node type: %T", file.Node())
+
+		return
 	}
 
 	// Remember the current file over all functions declared in it
-	var currentFile astutil.CurrentFile
+	currentFile := astutil.NewCurrentFile(p.Fset, node)
+	if !r.behavior.Enabled(config.IncludeGenerated) && currentFile.Generated() {
+		r.logSkip(ctx, p, node, "generated file")
 
-	// Loop over all function and method declarations
-	root, types := in.Root(), []ast.Node{
-		(*ast.File)(nil),
-		(*ast.FuncDecl)(nil),
+		return
+	}
+
+	// Loop bounds are only rewritten as constants with suggested fixes
+	us.RewriteLoopConsts = !r.behavior.Enabled(config.ReportOnly) && !currentFile.Generated()
+
+	// Count statements of all functions in a single walk
+	var statements map[*ast.FuncDecl]int
+	if r.maxFuncSize >= 0 {
+		statements = funcStatements(file)
 	}
 
 	// Loop over all function and method declarations
-	root.Inspect(types, func(i inspector.Cursor) bool {
-		switch node := i.Node().(type) {
-		case *ast.File:
-			currentFile = astutil.NewCurrentFile(p.Fset, node)
-			descend := r.behavior.Enabled(config.IncludeGenerated) || !currentFile.Generated()
+	for i := range file.Children() {
+		node, ok := i.Node().(*ast.FuncDecl)
+		if !ok || node.Body == nil {
+			continue
+		}
 
-			return descend
+		// Skip functions with nolint comment
+		if node.Doc != nil && astutil.CommentHasNoLint(node.Doc.List[len(node.Doc.List)-1]) {
+			r.logSkip(ctx, p, node, "nolint directive on function")
 
-		case *ast.FuncDecl:
-			if node.Body == nil {
-				return false
-			}
+			continue
+		}
 
-			if !currentFile.Valid() {
-				astutil.InternalError(p, node, "Function declaration %s without file info", node.Name.Name)
+		// Skip pathologically large functions, typically generated code
+		if r.maxFuncSize >= 0 && statements[node] > r.maxFuncSize {
+			r.logSkip(ctx, p, node, fmt.Sprintf("more than %d statements", r.maxFuncSize))
+			report.SkippedFunc(p, node, r.maxFuncSize, filter, r.quote)
 
-				return false
-			}
+			continue
+		}
 
-			// Skip functions with nolint comment
-			if node.Doc != nil && astutil.CommentHasNoLint(node.Doc.List[len(node.Doc.List)-1]) {
-				return false
-			}
+		body := i.ChildAt(edge.FuncDecl_Body, -1)
 
-			body := i.ChildAt(edge.FuncDecl_Body, -1)
+		// Fast path: nothing to analyze without local declarations
+		if r.fastPath && !usage.HasDeclarations(body) {
+			r.reportMetrics(p, node, report.Diagnostics{})
+
+			continue
+		}
 
-			// Stage 1: Collect all movable variable declarations and track variable uses
-			usageData, usageDiagnostics := us.TrackUsage(ctx, body, node)
+		// Stage 1: Collect all movable variable declarations and track variable uses
+		usageData, usageDiagnostics := us.TrackUsage(ctx, body, node)
+		result.scopeRanges = appendScopeRanges(result.scopeRanges, body.Inspector(), usageData)
 
-			var moves []target.MoveTarget
+		var (
+			moves        []target.MoveTarget
+			joins        []target.Join
+			inlineRanges []target.InlineRange
+			tsUnused     []target.TypeSwitchUnused
+			condInlines  []target.CondInline
+			introBlocks  []target.IntroBlock
+			swaps        []target.Swap
+		)
+
+		// Stage 2: compute minimum safe scopes, select target nodes and resolve conflicts
+		if usageData.HasScopeRanges() {
+			// There are movable variable declarations
+			moves = ts.SelectTargets(ctx, currentFile, body, usageData)
+			result.addMoves(p, body.Inspector(), moves, r.behavior)
+
+			if r.analyzers.Enabled(config.JoinAnalyzer) {
+				joins = ts.Joins(currentFile, body, usageData.AllScopeRanges())
+			}
 
-			// Stage 2: compute minimum safe scopes, select target nodes and resolve conflicts
-			if usageData.HasScopeRanges() {
-				// There are movable variable declarations
-				moves = ts.SelectTargets(ctx, currentFile, body, usageData)
+			if r.analyzers.Enabled(config.SwapAnalyzer) {
+				swaps = ts.Swaps(body, moves)
 			}
 
-			diagnostics := report.Diagnostics{
-				Moves:       moves,
-				Diagnostics: usageDiagnostics,
+			if r.behavior.Enabled(config.IntroduceBlocks) {
+				introBlocks = ts.IntroBlocks(body, usageData.AllScopeRanges())
 			}
+		}
+
+		if r.analyzers.Enabled(config.InlineRangeAnalyzer) {
+			inlineRanges = ts.InlineRanges(body)
+		}
+
+		if r.analyzers.Enabled(config.TypeSwitchUnusedAnalyzer) {
+			tsUnused = ts.TypeSwitchUnused(body)
+		}
+
+		if r.analyzers.Enabled(config.CondInlineAnalyzer) {
+			condInlines = ts.CondInlines(body)
+		}
 
-			// Stage 3: Generate diagnostics with suggested fixes
-			report.ProcessDiagnostics(ctx, p, currentFile, i, diagnostics, r.behavior)
+		diagnostics := report.Diagnostics{
+			Moves:        moves,
+			Joins:        joins,
+			InlineRanges: inlineRanges,
+			TSUnused:     tsUnused,
+			CondInlines:  condInlines,
+			IntroBlocks:  introBlocks,
+			Swaps:        swaps,
+			Diagnostics:  usageDiagnostics,
+		}
+
+		// Stage 3: Generate diagnostics with suggested fixes
+		report.ProcessDiagnostics(ctx, p, currentFile, i, diagnostics, r.behavior, r.renameConfig, filter, r.quote, r.fixComment)
 
-			return true
+		r.reportMetrics(p, node, diagnostics)
+	}
+}
+
+// funcStatements counts the statements of all function and method declarations of a file in a single walk.
+// The function bodies themselves are not counted.
+func funcStatements(file inspector.Cursor) map[*ast.FuncDecl]int {
+	statements := make(map[*ast.FuncDecl]int)
+
+	var current *ast.FuncDecl
+	for c := range file.Preorder() {
+		switch n := c.Node().(type) {
+		case *ast.FuncDecl:
+			current = n
 
-		default:
-			astutil.InternalError(p, node, "Unexpected node type: %T", node)
+		case *ast.GenDecl:
+			if kind, _ := c.ParentEdge(); kind == edge.File_Decls {
+				current = nil // Function literals of package level declarations are not counted
+			}
 
-			return false
+		case ast.Stmt:
+			if kind, _ := c.ParentEdge(); current != nil && kind != edge.FuncDecl_Body {
+				statements[current]++
+			}
 		}
-	})
+	}
+
+	return statements
+}
+
+// logSkip logs a node skipped by the analyzer when verbose skip logging is enabled.
+func (r *runOptions) logSkip(ctx context.Context, p *analysis.Pass, node ast.Node, reason string) {
+	if r.logger == nil {
+		return
+	}
 
-	return nil, nil
+	r.logger.LogAttrs(ctx, slog.LevelInfo, "Skipping",
+		slog.String("position", p.Fset.Position(node.Pos()).String()),
+		slog.String("reason", reason))
 }
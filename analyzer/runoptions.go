@@ -17,10 +17,18 @@
 package analyzer
 
 import (
+	"fmt"
+	"log/slog"
+	"os"
+	"reflect"
+	"sync"
+
 	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/analysis/passes/inspect"
 
 	"fillmore-labs.com/scopeguard/internal/config"
+	"fillmore-labs.com/scopeguard/internal/report"
+	"fillmore-labs.com/scopeguard/internal/scope"
 )
 
 // runOptions represent configuration runOptions for the scopeguard analyzer.
@@ -34,33 +42,128 @@ type runOptions struct {
 	// maxLines specifies the maximum number of lines a declaration can span to be considered for moving
 	// into control flow initializers.
 	maxLines int
+
+	// minSpan specifies the minimum number of lines from a declaration to the end of its usage scope
+	// for the declaration to be reported.
+	minSpan int
+
+	// maxAbsorb limits the number of declarations combined into another one when moving to
+	// control flow initializers. Negative values mean unlimited.
+	maxAbsorb int
+
+	// maxWidth limits the width of control flow initializer lines with combined declarations.
+	// Negative values mean unlimited.
+	maxWidth int
+
+	// maxFuncSize limits the number of statements of analyzed functions. Negative values mean unlimited.
+	maxFuncSize int
+
+	// targetKinds are the kinds of target scopes declarations can be moved to.
+	targetKinds scope.Kind
+
+	// keepPragmas are comments keeping declarations on their line at their scope.
+	keepPragmas pragmaList
+
+	// renameLimit is the maximum number of suffixes tried when renaming a shadowed variable.
+	renameLimit int
+
+	// fastPath skips functions without local declarations. Disabled only for benchmarks.
+	fastPath bool
+
+	// logger, if set, receives a record for each declaration not considered for moving.
+	logger *slog.Logger
+
+	// metrics, if set, receives the finding counts of each analyzed function.
+	metrics func(FuncMetrics)
+
+	// baselineFile, if set, is the path of a baseline of accepted findings.
+	baselineFile string
+
+	// baseline loads the baseline once.
+	baseline func() (*report.Baseline, error)
+
+	// changedLines, if set, restricts reported findings to these line ranges.
+	changedLines report.LineRanges
+
+	// category, if set, is the category of all reported diagnostics.
+	category string
+
+	// fixComment, if set, is the comment preceding declarations moved to blocks.
+	fixComment string
+
+	// quote is the quote style of variable names in messages.
+	quote report.QuoteStyle
+
+	// testOptions are applied on top of these options for test files.
+	testOptions Options
+
+	// test, if set, are the options used for test files.
+	test *runOptions
 }
 
 // makeRunOptions returns a [options] struct with overriding [Options] applied.
 func makeRunOptions(opts Options) *runOptions {
 	r := defaultRunOptions()
 	opts.apply(r)
+	r.baseline = sync.OnceValues(r.loadBaseline)
+	r.setFixComment()
+
+	if len(r.testOptions) > 0 {
+		t := *r
+		t.testOptions.apply(&t)
+		t.testOptions, t.test = nil, nil
+		t.setFixComment()
+		r.test = &t
+	}
 
 	return r
 }
 
+// loadBaseline parses the baseline file, if configured.
+func (r *runOptions) loadBaseline() (*report.Baseline, error) {
+	if r.baselineFile == "" {
+		return nil, nil
+	}
+
+	f, err := os.Open(r.baselineFile)
+	if err != nil {
+		return nil, fmt.Errorf("scopeguard: can't open baseline: %w", err)
+	}
+	defer f.Close()
+
+	baseline, err := report.ParseBaseline(f)
+	if err != nil {
+		return nil, fmt.Errorf("scopeguard: baseline %s: %w", r.baselineFile, err)
+	}
+
+	return baseline, nil
+}
+
 // defaultRunOptions initializes and returns a new Options instance with default values.
 func defaultRunOptions() *runOptions {
 	return &runOptions{
-		analyzers: config.NewBitMask(config.ScopeAnalyzer | config.ShadowAnalyzer | config.NestedAssignAnalyzer),
-		behavior:  config.NewBitMask(config.CombineDeclarations),
-		maxLines:  -1,
+		analyzers:   config.NewBitMask(config.ScopeAnalyzer | config.ShadowAnalyzer | config.NestedAssignAnalyzer),
+		behavior:    config.NewBitMask(config.CombineDeclarations | config.AnalyzeClosures),
+		maxLines:    -1,
+		maxAbsorb:   -1,
+		maxWidth:    -1,
+		maxFuncSize: -1,
+		targetKinds: scope.AllKinds,
+		renameLimit: report.DefaultRenameLimit,
+		fastPath:    true,
+		category:    name,
 	}
 }
 
 // analyzer returns a scopeguard *[analysis.analyzer] instance.
 func (r *runOptions) analyzer() *analysis.Analyzer {
 	a := &analysis.Analyzer{
-		Name:     name,
-		Doc:      doc,
-		URL:      url,
-		Run:      r.run,
-		Requires: []*analysis.Analyzer{inspect.Analyzer},
+		Name:       name,
+		Doc:        doc,
+		URL:        url,
+		Run:        r.run,
+		Requires:   []*analysis.Analyzer{inspect.Analyzer},
+		ResultType: reflect.TypeFor[*Result](),
 	}
 
 	return a
@@ -0,0 +1,55 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import "fmt"
+
+type assertT struct{}
+
+func (*assertT) String() string { return "" }
+
+// Compile-time interface assertions with the blank identifier.
+func blankAssertion() {
+	var _ fmt.Stringer = (*assertT)(nil)
+
+	var (
+		_ fmt.Stringer = (*assertT)(nil)
+		_ fmt.Stringer = &assertT{}
+	)
+}
+
+// Throwaway variable only used by an assertion in the same scope.
+func assertionChain() {
+	x := (*assertT)(nil)
+	var _ fmt.Stringer = x
+}
+
+// Throwaway variable only used by an assertion in a nested block.
+func assertionChainNested(ok bool) {
+	x := (*assertT)(nil) // want "Variable 'x' can be moved to tighter block scope"
+	if ok {
+		var _ fmt.Stringer = x
+	}
+}
+
+// Assertion through an interface variable redeclared later.
+func assertionRedeclared() {
+	var s fmt.Stringer = (*assertT)(nil)
+	_ = s
+	s, n := &assertT{}, 1
+	fmt.Println(s, n)
+}
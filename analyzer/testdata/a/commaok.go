@@ -0,0 +1,98 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import "fmt"
+
+// Comma-ok type assertion used only in the if statement.
+func commaOkAssertion(x any) {
+	v, ok := x.(int) // want "Variables 'v' and 'ok' can be moved to tighter if scope"
+	if ok {
+		fmt.Println(v)
+	}
+}
+
+// Comma-ok map index used only in the if statement.
+func commaOkMapIndex(m map[string]int) {
+	v, ok := m["key"] // want "Variables 'v' and 'ok' can be moved to tighter if scope"
+	if ok {
+		fmt.Println(v)
+	}
+}
+
+// Comma-ok map index with blank value.
+func commaOkBlankValue(m map[string]int) {
+	_, ok := m["key"] // want "Variable 'ok' can be moved to tighter if scope"
+	if !ok {
+		fmt.Println("missing")
+	}
+}
+
+// Comma-ok type assertion with the value only used in the condition.
+func commaOkValueInCondition(x any) {
+	s, ok := x.(string) // want "Variables 's' and 'ok' can be moved to tighter if scope"
+	if ok && s != "" {
+		fmt.Println("non-empty")
+	}
+}
+
+// Comma-ok type assertion where the value is overwritten before being read.
+func commaOkUnused(x any) {
+	v, ok := x.(int) // want "Variables 'v' and 'ok' can be moved to tighter if scope"
+	if ok {
+		v = 1
+		fmt.Println(v)
+	}
+}
+
+// The ok result is redeclared before being read, so it is replaced by the blank identifier.
+func commaOkRedeclared(x any) {
+	v, ok := x.(int) // want "Variable 'v' can be moved to tighter if scope"
+	if v > 0 {
+		fmt.Println(v)
+	}
+	s, ok := x.(string)
+	fmt.Println(s, ok)
+}
+
+// Comma-ok forms can't be combined with other declarations, since they must be the only value,
+// so conflicting moves to the same if statement are reported without fixes.
+func commaOkCombine(x any, s []int) {
+	v, ok := x.(int) // want "Variables 'v' and 'ok' can be moved to tighter if scope"
+	n := len(s)      // want "Variable 'n' can be moved to tighter if scope"
+	if ok && n > 0 {
+		fmt.Println(v)
+	}
+}
+
+// Comma-ok map index conflicting with a preceding declaration.
+func commaOkCombineAfter(m map[string]int, s []int) {
+	n := len(s)    // want "Variable 'n' can be moved to tighter if scope"
+	v, ok := m[""] // want "Variables 'v' and 'ok' can be moved to tighter if scope"
+	if ok && n > 0 {
+		fmt.Println(v)
+	}
+}
+
+// The value is used after the if statement, so the declaration stays.
+func commaOkUsedAfter(x any) {
+	v, ok := x.(int)
+	if !ok {
+		return
+	}
+	fmt.Println(v)
+}
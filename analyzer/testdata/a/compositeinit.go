@@ -0,0 +1,58 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import "fmt"
+
+type point struct{ x, y int }
+
+// Composite literals with a type name would start the if block, they need parentheses.
+func initAddressOf() {
+	p := &point{1, 2} // want "Variable 'p' can be moved to tighter if scope"
+	if p.x > 0 {
+		fmt.Println(p)
+	}
+}
+
+func initTypeName() {
+	p := point{1, 2} // want "Variable 'p' can be moved to tighter if scope"
+	if p.x > 0 {
+		fmt.Println(p)
+	}
+}
+
+// Slice, array and map literals are unambiguous.
+func initSlice() {
+	s := []int{1, 2} // want "Variable 's' can be moved to tighter if scope"
+	if len(s) > 1 {
+		fmt.Println(s)
+	}
+}
+
+func initMap() {
+	m := map[string]int{"a": 1} // want "Variable 'm' can be moved to tighter if scope"
+	if len(m) > 0 {
+		fmt.Println(m)
+	}
+}
+
+func initSliceOfTypeName() {
+	s := []point{point{1, 2}} // want "Variable 's' can be moved to tighter if scope"
+	if len(s) > 0 {
+		fmt.Println(s)
+	}
+}
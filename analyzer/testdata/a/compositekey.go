@@ -0,0 +1,59 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import "fmt"
+
+func computeKey() string { return "key" }
+
+// Variable used only as a map literal key moves to where the literal is.
+func compositeKeyBlock(ok bool) {
+	k := computeKey() // want "Variable 'k' can be moved to tighter block scope"
+	if ok {
+		m := map[string]int{k: 1}
+		fmt.Println(m)
+	}
+}
+
+// Variable used only as a map literal key in the condition moves into the init field.
+func compositeKeyCondition() {
+	k := computeKey() // want "Variable 'k' can be moved to tighter if scope"
+	if len(map[string]int{k: 1}) > 0 {
+		fmt.Println("not empty")
+	}
+}
+
+// Variable used both as key and as value of a map literal.
+func compositeKeyValue(ok bool) {
+	k := computeKey() // want "Variable 'k' can be moved to tighter block scope"
+	if ok {
+		m := map[string]string{k: k}
+		fmt.Println(m)
+	}
+}
+
+type keyed struct{ k string }
+
+// Struct literal field names are not uses of a variable with the same name.
+func compositeFieldName(ok bool) {
+	k := computeKey() // want "Variable 'k' can be moved to tighter block scope"
+	v := keyed{k: "field"}
+	if ok {
+		fmt.Println(k)
+	}
+	fmt.Println(v)
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import "fmt"
+
+// Deferred call arguments are evaluated at the defer statement, the later assignment is another use.
+func deferredArgument() {
+	x := 1
+	defer fmt.Println(x)
+	x = 2
+	fmt.Println(x)
+}
+
+// Deferred closures read the variable on return, after the later assignment.
+func deferredClosure() {
+	x := 1
+	defer func() { fmt.Println(x) }()
+	x = 2
+}
+
+// The declaration moves in front of the defer statement, which still evaluates the initial value.
+func deferredArgumentBlock(c bool) {
+	x := 1 // want "Variable 'x' can be moved to tighter block scope"
+	if c {
+		defer fmt.Println(x)
+		x = 2
+		fmt.Println(x)
+	}
+}
+
+// The closure reads the value assigned last in the block it is moved to.
+func deferredClosureBlock(c bool) {
+	x := 1 // want "Variable 'x' can be moved to tighter block scope"
+	if c {
+		defer func() { fmt.Println(x) }()
+		x = 2
+	}
+}
+
+// Deferred closures reading a variable assigned after the block keep the declaration.
+func deferredClosureAssignedLater(c bool) {
+	x := 1
+	if c {
+		defer func() { fmt.Println(x) }()
+	}
+	x = 2
+}
+
+// Deferred arguments followed by an assignment after the block keep the declaration.
+func deferredArgumentAssignedLater(c bool) {
+	x := 1
+	if c {
+		defer fmt.Println(x)
+	}
+	x = 2
+	fmt.Println(x)
+}
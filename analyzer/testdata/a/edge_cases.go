@@ -87,6 +87,30 @@ func switchCases() {
 	}
 }
 
+// Two variables used across all cases of a tagless switch land in its init together.
+func switchCasesCombined() {
+	val := compute() // want "Variable 'val' can be moved to tighter switch scope"
+	limit := 2       // want "Variable 'limit' can be moved to tighter switch scope"
+	switch {
+	case val < limit:
+		fmt.Println("small:", val)
+	default:
+		fmt.Println("other:", val, limit)
+	}
+}
+
+// The tag variable is combined with another variable used in all cases.
+func switchTagCombined() {
+	val := compute() // want "Variable 'val' can be moved to tighter switch scope"
+	prefix := "v:"   // want "Variable 'prefix' can be moved to tighter switch scope"
+	switch val {
+	case 1:
+		fmt.Println(prefix, "one")
+	default:
+		fmt.Println(prefix, val)
+	}
+}
+
 // Variable used in range loop key/value - should NOT move.
 func rangeKeyValue() {
 	nums := []int{1, 2, 3}
@@ -158,6 +182,36 @@ func goStatement() {
 	}()
 }
 
+// Variable used only in a deferred closure inside a nested block.
+func deferClosure() {
+	x := 1 // want "Variable 'x' can be moved to tighter block scope"
+	{
+		fmt.Println("body")
+		defer func() {
+			fmt.Println(x) // Captured by closure
+		}()
+	}
+}
+
+// Variable used only in a deferred closure in the same block.
+func deferClosureSameBlock() {
+	x := 1
+	fmt.Println("body")
+	defer func() {
+		fmt.Println(x) // Captured by closure
+	}()
+}
+
+// Variable used only in a goroutine closure inside an if body.
+func goClosureNested() {
+	x := 1 // want "Variable 'x' can be moved to tighter block scope"
+	if true {
+		go func() {
+			fmt.Println(x) // Captured by closure
+		}()
+	}
+}
+
 // Empty if body.
 func emptyIfBody() {
 	x := 1 // want "Variable 'x' can be moved to tighter if scope"
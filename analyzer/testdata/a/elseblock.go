@@ -0,0 +1,62 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import "fmt"
+
+// Declaration used only in the else block.
+func elseBlock(ok bool) {
+	x := 1 // want "Variable 'x' can be moved to tighter block scope"
+	if ok {
+		fmt.Println("ok")
+	} else {
+		fmt.Println(x)
+	}
+}
+
+// Declaration used only in the final else block of an else-if chain.
+func elseIfChain(a, b bool) {
+	x := 1 // want "Variable 'x' can be moved to tighter block scope"
+	if a {
+		fmt.Println("a")
+	} else if b {
+		fmt.Println("b")
+	} else {
+		fmt.Println(x)
+	}
+}
+
+// Declaration used only in an else-if condition.
+func elseIfCondition(a bool, s string) {
+	n := len(s) // want "Variable 'n' can be moved to tighter if scope"
+	if a {
+		fmt.Println("a")
+	} else if n > 0 {
+		fmt.Println(n)
+	}
+}
+
+// Var declaration used only in the else block.
+func elseBlockVar(ok bool) {
+	var y int // want "Variable 'y' can be moved to tighter block scope"
+	if ok {
+		fmt.Println("ok")
+	} else {
+		y = 2
+		fmt.Println(y)
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import "fmt"
+
+// Sequential if statements with their own err in the initializer are not flagged.
+func sequentialIfInit() error {
+	if err := work(); err != nil {
+		return err
+	}
+
+	if err := work(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// The first err moves into the if statement, the second if statement gets its own err.
+func sequentialDecls() error {
+	err := work() // want "Variable 'err' can be moved to tighter if scope"
+	if err != nil {
+		return err
+	}
+
+	if err := work(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// After moving the first declaration, the redeclaration declares a new err, which is still used.
+func redeclaredErr() error {
+	err := work() // want "Variable 'err' can be moved to tighter if scope"
+	if err != nil {
+		return err
+	}
+
+	n, err := twoResults()
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(n)
+
+	return nil
+}
+
+// A reassigned err is neither moved nor flagged as unused.
+func reassignedErr() error {
+	err := work()
+	if err != nil {
+		return err
+	}
+
+	err = work()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import "fmt"
+
+// Local in a generic function used only in an inner block.
+func genericLocal[T any](x T) {
+	y := x // want "Variable 'y' can be moved to tighter block scope"
+	if true {
+		fmt.Println(y)
+	}
+}
+
+// Local in a generic function moved to an init field.
+func genericInit[T comparable](x, z T) {
+	y := x // want "Variable 'y' can be moved to tighter if scope"
+	if y == z {
+		fmt.Println(y)
+	}
+}
+
+type box[T any] struct{ v T }
+
+// Local in a method of a generic type.
+func (b box[T]) genericMethod() {
+	v := b.v // want "Variable 'v' can be moved to tighter block scope"
+	if true {
+		fmt.Println(v)
+	}
+}
+
+// Local in a generic function literal boundary must not be crossed.
+func genericClosure[T any](x T) func() T {
+	y := x
+	return func() T {
+		{
+			return y
+		}
+	}
+}
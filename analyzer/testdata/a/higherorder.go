@@ -0,0 +1,60 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Closures passed to higher-order functions are analyzed like assigned ones.
+func sortFunc(xs []int) {
+	slices.SortFunc(xs, func(a, b int) int {
+		d := a - b // want "Variable 'd' can be moved to tighter if scope"
+		if d != 0 {
+			return d
+		}
+
+		return 0
+	})
+}
+
+func indexFunc(xs []string, prefix string) int {
+	return slices.IndexFunc(xs, func(s string) bool {
+		p := strings.ToLower(prefix) // want "Variable 'p' can be moved to tighter block scope"
+		if s != "" {
+			return strings.HasPrefix(strings.ToLower(s), p)
+		}
+
+		return false
+	})
+}
+
+// Closures passed as arguments to other function literals are analyzed, too.
+func deferredArg(xs []int) {
+	defer func(f func() int) {
+		fmt.Println(f())
+	}(func() int {
+		n := len(xs) // want "Variable 'n' can be moved to tighter if scope"
+		if n > 0 {
+			return n
+		}
+
+		return -1
+	})
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import "fmt"
+
+// Multi-line immediately invoked function literal used only in a nested block.
+func iifeNestedBlock(ok bool) {
+	total := func() int { // want "Variable 'total' can be moved to tighter block scope"
+		sum := 0
+		for i := range 10 {
+			sum += i
+		}
+
+		return sum
+	}()
+	if ok {
+		if total > 0 {
+			fmt.Println(total)
+		}
+	}
+}
+
+// Multi-line immediately invoked function literal used in a case expression is not moved into the switch init field.
+func iifeCondition(ok bool) {
+	total := func() int { // want "Variable 'total' can be moved to tighter block scope"
+		sum := 0
+		for i := range 10 {
+			sum += i
+		}
+
+		return sum
+	}()
+	if ok {
+		switch {
+		case total > 0:
+			fmt.Println("positive")
+		}
+	}
+}
+
+// Single-line immediately invoked function literal moves into the init field.
+func iifeShort(ok bool) {
+	total := func() int { return compute() }() // want "Variable 'total' can be moved to tighter if scope"
+	if total > 0 {
+		fmt.Println(ok)
+	}
+}
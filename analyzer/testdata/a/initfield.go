@@ -94,6 +94,37 @@ func switchTagAndCases() {
 	}
 }
 
+// Variable used in switch tag and a single case body.
+func switchTagAndSingleCase() {
+	value := compute() // want "Variable 'value' can be moved to tighter switch scope"
+	switch value {
+	case 1:
+		fmt.Println("one")
+	default:
+		fmt.Println(value)
+	}
+}
+
+// Variable used in a nested switch tag and case body moves to the switch, not the enclosing block.
+func switchTagNested() {
+	value := compute() // want "Variable 'value' can be moved to tighter switch scope"
+	{
+		switch value {
+		case 1:
+			fmt.Println(value)
+		}
+	}
+}
+
+// Switch already has an Init field - the switch scope can't be used.
+func switchTagInitTaken() {
+	value := compute()
+	switch one := 1; value {
+	case one:
+		fmt.Println(value)
+	}
+}
+
 // Variable that can be moved to type switch Init field.
 func typeSwitchInit() {
 	x := computeAny() // want "Variable 'x' can be moved to tighter type switch scope"
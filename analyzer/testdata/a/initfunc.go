@@ -0,0 +1,51 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import "fmt"
+
+// Package-level declarations are not analyzed.
+var (
+	pkgValue  = pkgCompute()
+	pkgUnused int
+)
+
+func pkgCompute() int { return 1 }
+
+// Function literals in package-level declarations are not analyzed either.
+var pkgFunc = func() {
+	x := 1
+	if x > 0 {
+		fmt.Println(pkgValue)
+	}
+}
+
+// init functions are analyzed like any other function.
+func init() {
+	x := pkgCompute() // want "Variable 'x' can be moved to tighter if scope"
+	if x > 0 {
+		fmt.Println(x)
+	}
+}
+
+// Multiple init functions may be declared.
+func init() {
+	y := 2 // want "Variable 'y' can be moved to tighter block scope"
+	if pkgValue > 0 {
+		fmt.Println(y)
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import "fmt"
+
+// A label only targeted by a break from a nested loop is no barrier for moves past the labeled loop.
+func labeledBreakBefore(rows [][]int, cond bool) {
+	x := compute() // want "Variable 'x' can be moved to tighter block scope"
+outer:
+	for _, row := range rows {
+		for _, v := range row {
+			if v < 0 {
+				break outer
+			}
+		}
+	}
+	if cond {
+		fmt.Println(x)
+	}
+}
+
+// A label only targeted by a continue from a nested loop is no barrier either.
+func labeledContinueBefore(rows [][]int, cond bool) {
+	x := compute() // want "Variable 'x' can be moved to tighter block scope"
+outer:
+	for _, row := range rows {
+		for _, v := range row {
+			if v < 0 {
+				continue outer
+			}
+		}
+	}
+	if cond {
+		fmt.Println(x)
+	}
+}
+
+// The declaration is used in the nested loop breaking to the outer label, so it can't move into the loops.
+func labeledBreakUse(rows [][]int) {
+	limit := compute()
+outer:
+	for _, row := range rows {
+		for _, v := range row {
+			if v > limit {
+				break outer
+			}
+		}
+	}
+}
+
+// The declaration moves into the labeled loop statement's enclosing block, keeping the label intact.
+func labeledBreakInside(rows [][]int, cond bool) {
+	limit := compute() // want "Variable 'limit' can be moved to tighter block scope"
+	if cond {
+	outer:
+		for _, row := range rows {
+			for _, v := range row {
+				if v > limit {
+					break outer
+				}
+			}
+		}
+	}
+}
+
+// A label targeted by a backward goto is a barrier, since the goto would re-execute a moved declaration.
+func gotoBefore(n int) {
+	x := compute()
+again:
+	if n > 0 {
+		n--
+		fmt.Println(x)
+		goto again
+	}
+}
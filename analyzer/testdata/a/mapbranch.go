@@ -0,0 +1,50 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import "fmt"
+
+// The map is written in one branch and read in the other, so it moves to the if statement, not a branch.
+func mapBranches(c bool) {
+	m := map[string]int{} // want "Variable 'm' can be moved to tighter if scope"
+	if c {
+		m["a"] = 1
+	} else {
+		fmt.Println(m["a"])
+	}
+}
+
+// The slice is appended to in one case and read in another, so it moves to the switch statement.
+func sliceCases(n int) {
+	s := make([]int, 0, 1) // want "Variable 's' can be moved to tighter switch scope"
+	switch n {
+	case 1:
+		s = append(s, n)
+		fmt.Println(s)
+	default:
+		fmt.Println(len(s))
+	}
+}
+
+// The map is written in a branch and read after it, so it stays.
+func mapBranchAndAfter(c bool) {
+	m := make(map[string]int)
+	if c {
+		m["a"] = 1
+	}
+	fmt.Println(m)
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// Method calls on a variable are uses of the receiver.
+func bufferMethod(cond bool) {
+	b := bytes.Buffer{} // want "Variable 'b' can be moved to tighter block scope"
+	if cond {
+		b.WriteString("x")
+		fmt.Println(b.String())
+	}
+}
+
+// A mutating method call outside the block keeps the declaration in place.
+func bufferWrittenOutside(cond bool) {
+	b := bytes.Buffer{}
+	b.WriteString("x")
+
+	if cond {
+		fmt.Println(b.String())
+	}
+}
+
+// A method call inside the block is a use, even when the variable is read afterward.
+func bufferReadAfter(cond bool) string {
+	b := bytes.Buffer{}
+	if cond {
+		b.WriteString("x")
+	}
+
+	return b.String()
+}
+
+type counter struct{ n int }
+
+func (c *counter) inc() { c.n++ }
+
+// Pointer receiver calls on addressable values are uses, too.
+func mutatingMethod(cond bool) {
+	var c counter // want "Variable 'c' can be moved to tighter block scope"
+	if cond {
+		c.inc()
+		fmt.Println(c.n)
+	}
+}
+
+// The receiver of a method call in a condition extends the scope to the if statement.
+func methodInCondition(cond bool) {
+	var c counter
+	c.inc()
+
+	if c.n > 0 && cond {
+		c.inc()
+	}
+}
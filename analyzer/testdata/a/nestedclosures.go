@@ -0,0 +1,101 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import "fmt"
+
+// Each closure level reports uses of its own err after shadowing it in a nested block.
+func shadowEachLevel() {
+	err := work()
+	if err := work(); err != nil {
+		fmt.Println(err)
+	}
+
+	func() {
+		err := work()
+		if err := work(); err != nil {
+			fmt.Println(err)
+		}
+
+		func() {
+			err := work()
+			if err := work(); err != nil {
+				fmt.Println(err)
+			}
+
+			fmt.Println(err) // want "Identifier 'err' used after previously shadowed"
+		}()
+
+		fmt.Println(err) // want "Identifier 'err' used after previously shadowed"
+	}()
+
+	fmt.Println(err) // want "Identifier 'err' used after previously shadowed"
+}
+
+// Shadowing doesn't cross function literals: an innermost closure shadowing err
+// from two closures out doesn't affect uses at the outer levels.
+func innermostShadow() {
+	err := work()
+
+	func() {
+		fmt.Println(err)
+
+		func() {
+			fmt.Println(err)
+
+			func() {
+				if err := work(); err != nil {
+					fmt.Println(err)
+				}
+
+				fmt.Println(err)
+			}()
+
+			fmt.Println(err)
+		}()
+
+		fmt.Println(err)
+	}()
+
+	fmt.Println(err)
+}
+
+// Each closure shadows only its own err, independent of the enclosing levels.
+func innerLevelOnly() {
+	err := work()
+
+	func() {
+		func() {
+			err := work()
+
+			func() {
+				if err := work(); err != nil {
+					fmt.Println(err)
+				}
+			}()
+
+			{
+				err := work()
+				fmt.Println(err)
+			}
+
+			fmt.Println(err) // want "Identifier 'err' used after previously shadowed"
+		}()
+	}()
+
+	fmt.Println(err)
+}
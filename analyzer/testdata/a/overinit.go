@@ -0,0 +1,60 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import "fmt"
+
+func initValue() int { return 1 }
+
+// Init declarations only read in the body are moved into it.
+func overInitBody(c bool) {
+	if x := initValue(); c { // want "Variable 'x' can be moved to tighter block scope"
+		fmt.Println(x)
+	}
+}
+
+// Init declarations only read in the else branch are moved into it.
+func overInitElse(c bool) {
+	if x := initValue(); c { // want "Variable 'x' can be moved to tighter block scope"
+		fmt.Println("c")
+	} else {
+		fmt.Println(x)
+	}
+}
+
+// Init declarations read in the condition stay.
+func overInitCondition() {
+	if x := initValue(); x > 0 {
+		fmt.Println(x)
+	}
+}
+
+// Init declarations read in both branches stay.
+func overInitBothBranches(c bool) {
+	if x := initValue(); c {
+		fmt.Println(x)
+	} else {
+		fmt.Println(-x)
+	}
+}
+
+// Init declarations only read in the loop body are not moved into it.
+func overInitLoop(n int) {
+	for i, x := 0, initValue(); i < n; i++ {
+		fmt.Println(x)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import (
+	"errors"
+	"fmt"
+)
+
+// The named result is returned after a recovered panic, so its last assignment stays.
+func recoveredResult(cond bool) (n int) {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Println("recovered", r)
+		}
+	}()
+
+	n, ok := twoResults()
+	if ok != nil {
+		fmt.Println(n)
+	}
+
+	panic("failed")
+}
+
+// Other declarations still move in a function recovering from a panic.
+func recoveredMove(cond bool) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("recovered: %v", r)
+		}
+	}()
+
+	x := compute() // want "Variable 'x' can be moved to tighter block scope"
+	if cond {
+		fmt.Println(x)
+	}
+
+	panic("failed")
+}
+
+// A named result shadowed when panicking is still reported for the recovered return.
+func recoveredShadow() (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Println("recovered", r)
+		}
+	}()
+
+	{
+		err := errors.New("inner")
+		fmt.Println(err)
+	}
+
+	if err != nil { // want "Identifier 'err' used after previously shadowed"
+		panic(err)
+	}
+
+	return
+}
+
+// Without recover, a deferred function doesn't make the named result used.
+func deferredNoRecover(cond bool) (n int) {
+	defer fmt.Println("done")
+
+	n, ok := twoResults() // want "Variables 'n' and 'ok' can be moved to tighter if scope"
+	if ok != nil {
+		fmt.Println(n)
+	}
+
+	panic("failed")
+}
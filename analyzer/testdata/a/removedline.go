@@ -0,0 +1,39 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import "fmt"
+
+// The line of the moved declaration is removed entirely.
+func removedLine(ok bool) {
+	// want +1 "Variable 'v' can be moved to tighter block scope"
+	v := compute()
+	if ok {
+		fmt.Println(v)
+	}
+}
+
+// Lines of absorbed declarations are removed entirely.
+func removedAbsorbedLine() {
+	// want +2 "Variable 'n' can be moved to tighter if scope"
+	// want +2 "Variable 'limit' can be moved to tighter if scope"
+	n := compute()
+	limit := 10
+	if n < limit {
+		fmt.Println(n)
+	}
+}
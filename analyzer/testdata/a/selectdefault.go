@@ -0,0 +1,50 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import "fmt"
+
+// Declaration only used in the default clause of a select statement.
+func selectDefault(ch chan int) {
+	msg := compute() // want "Variable 'msg' can be moved to tighter select case scope"
+	select {
+	case v := <-ch:
+		fmt.Println(v)
+	default:
+		fmt.Println(msg)
+	}
+}
+
+// Default clause as the only clause.
+func selectOnlyDefault() {
+	msg := compute() // want "Variable 'msg' can be moved to tighter select case scope"
+	select {
+	default:
+		fmt.Println(msg)
+	}
+}
+
+// Declaration used in the default clause and another clause stays in place.
+func selectDefaultShared(ch chan int) {
+	msg := compute()
+	select {
+	case v := <-ch:
+		fmt.Println(v, msg)
+	default:
+		fmt.Println(msg)
+	}
+}
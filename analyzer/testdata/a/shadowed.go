@@ -69,6 +69,76 @@ func shadowedReturn() (i int) {
 	return // want "Identifier 'i' used after previously shadowed"
 }
 
+func shadowedErrorReturn() (err error) {
+	if err := work(); err != nil {
+		return err
+	}
+
+	if err := work(); err != nil {
+		return err
+	}
+
+	return
+}
+
+func shadowedErrorBlock() (err error) {
+	if err := work(); err != nil {
+		return err
+	}
+
+	{
+		err := work()
+		fmt.Println(err)
+	}
+
+	return // want "Identifier 'err' used after previously shadowed"
+}
+
+func shadowedErrorUsed() (err error) {
+	if err := work(); err != nil {
+		return err
+	}
+
+	fmt.Println(err) // want "Identifier 'err' used after previously shadowed"
+
+	return
+}
+
+func shadowedErrorReadBefore() (err error) {
+	defer func() { fmt.Println(err) }()
+
+	if err := work(); err != nil {
+		return err
+	}
+
+	return // want "Identifier 'err' used after previously shadowed"
+}
+
+func shadowedErrorAssigned() (err error) {
+	err = work()
+	fmt.Println(err)
+
+	if err := work(); err != nil {
+		return err
+	}
+
+	return // want "Identifier 'err' used after previously shadowed"
+}
+
+func shadowedErrorNotFinal(ok bool) (err error) {
+	if err := work(); err != nil {
+		return err
+	}
+
+	if ok {
+		return // want "Identifier 'err' used after previously shadowed"
+	}
+
+	return
+}
+
+func work() error { return nil }
+
 func shadowedFunc() {
 	var err error
 
@@ -0,0 +1,64 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import "fmt"
+
+func silencedTail() {
+	fmt.Println("work")
+	x := 1 // want "Variable 'x' is unused and can be removed"
+	_ = x
+}
+
+func silencedCall() {
+	fmt.Println("work")
+	x := compute() // want "Variable 'x' is unused and can be removed"
+	_ = x
+}
+
+func silencedMulti() {
+	x, _ := twoResults() // want "Variable 'x' is unused and can be removed"
+	_ = x
+}
+
+func silencedVar() {
+	var x, y int // want "Variables 'x' and 'y' are unused and can be removed"
+	_, _ = x, y
+}
+
+func silencedUsed() {
+	x := compute()
+	fmt.Println(x)
+	y := x // want "Variable 'y' is unused and can be removed"
+	_ = y
+}
+
+func silencedOuter() {
+	x := compute()
+	{
+		fmt.Println(x)
+	}
+	y := 1
+	_, _ = x, y
+}
+
+func silencedRedeclared() {
+	var err error
+	fmt.Println(err)
+	x, err := twoResults()
+	_ = x
+}
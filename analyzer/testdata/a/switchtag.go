@@ -0,0 +1,59 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import "fmt"
+
+// The variable is used in the tag and in a case body.
+func switchTagAndBody() {
+	v := compute() // want "Variable 'v' can be moved to tighter switch scope"
+	switch v {
+	case 1:
+		fmt.Println(v)
+	}
+}
+
+// The variable is used only in a case expression.
+func switchCaseExpression() {
+	v := compute() // want "Variable 'v' can be moved to tighter switch scope"
+	switch compute() {
+	case v:
+		fmt.Println("match")
+	}
+}
+
+// The variable is used in a case expression and in the matching body.
+func switchCaseExpressionAndBody() {
+	v := compute() // want "Variable 'v' can be moved to tighter switch scope"
+	switch compute() {
+	case v:
+		fmt.Println(v)
+	default:
+		fmt.Println("none")
+	}
+}
+
+// The variable is used only in a single case body.
+func switchCaseBodyOnly(n int) {
+	v := compute() // want "Variable 'v' can be moved to tighter case scope"
+	switch n {
+	case 1:
+		fmt.Println(v)
+	default:
+		fmt.Println("none")
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import (
+	"errors"
+	"fmt"
+)
+
+func twoResults() (int, error) { return 1, errors.New("pair") }
+
+// The first result is overwritten before being read, so only err moves and v is replaced by '_'.
+func twoResultsFirstUnused() error {
+	v, err := twoResults() // want "Variable 'err' can be moved to tighter if scope"
+	if err != nil {
+		return err
+	}
+
+	v, w := 3, 4
+	fmt.Println(v, w)
+
+	return nil
+}
+
+// Both results are read in the if statement, the declaration moves completely.
+func twoResultsBothUsed() {
+	v, err := twoResults() // want "Variables 'v' and 'err' can be moved to tighter if scope"
+	if err != nil {
+		fmt.Println(v, err)
+	}
+}
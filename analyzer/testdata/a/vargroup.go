@@ -0,0 +1,45 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import "fmt"
+
+func next() int { return 1 }
+
+// Grouped var declaration with a single remaining spec after the move.
+func varGroupMove() {
+	var ( // want "Variable 'x' can be moved to tighter block scope"
+		x = 1
+		y int
+	)
+	z, y := 2, 3 // want "Variables 'z' and 'y' can be moved to tighter block scope"
+	if true {
+		fmt.Println(x, y, z)
+	}
+}
+
+// Grouped var declaration with a single remaining spec after removal.
+func varGroupRemove() {
+	var ( // want "Variables 'y' and 'w' are unused and can be removed"
+		y int
+		w = next()
+	)
+	x, y, w := 1, 2, 3 // want "Variables 'x', 'y' and 'w' can be moved to tighter block scope"
+	if true {
+		fmt.Println(x, y, w)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import "fmt"
+
+// Call initializer moved into a while-style loop whose condition uses the variable.
+func whileCall() {
+	x := compute() // want "Variable 'x' can be moved to tighter for scope"
+	for x > 0 {
+		x--
+	}
+}
+
+// Trailing comment on the moved declaration.
+func whileComment() {
+	x := compute() // want "Variable 'x' can be moved to tighter for scope"
+	// countdown
+	for x > 0 {
+		fmt.Println(x)
+		x--
+	}
+}
+
+// Labeled while-style loop.
+func whileLabeled() {
+	x := compute() // want "Variable 'x' can be moved to tighter for scope"
+outer:
+	for x > 0 {
+		for {
+			x--
+			continue outer
+		}
+	}
+}
+
+// Condition spanning multiple lines.
+func whileMultiline(limit int) {
+	x := compute() // want "Variable 'x' can be moved to tighter for scope"
+	for x > 0 &&
+		x < limit {
+		x--
+	}
+}
+
+// Variable still used after the loop stays in place.
+func whileUsedAfter() {
+	x := compute()
+	for x > 0 {
+		x--
+	}
+
+	fmt.Println(x)
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package addresshints
+
+import "fmt"
+
+type buffer struct{ data []byte }
+
+func fill(b *buffer) { b.data = append(b.data, 0) }
+
+func addressTaken(ok bool) {
+	var buf buffer // want "Variable 'buf' can be moved to tighter block scope, shortening the lifetime of its taken address"
+	if ok {
+		fill(&buf)
+		fmt.Println(buf)
+	}
+}
+
+func parenthesized(ok bool) {
+	var buf buffer // want "Variable 'buf' can be moved to tighter block scope, shortening the lifetime of its taken address"
+	if ok {
+		fill(&(buf))
+	}
+}
+
+func valueOnly(ok bool) {
+	n := 1 // want "Variable 'n' can be moved to tighter block scope \\(sg:mov\\)"
+	if ok {
+		fmt.Println(n)
+	}
+}
+
+func notMovable(ok bool) {
+	var buf buffer
+	if ok {
+		fill(&buf)
+	}
+	fmt.Println(buf)
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package baseline
+
+import "fmt"
+
+func compute() (int, error) { return 1, nil }
+
+func accepted(c bool) {
+	x := 1
+	if c {
+		fmt.Println(x)
+	}
+}
+
+func acceptedShifted(c bool) {
+	// The baseline entry refers to the line before this comment.
+	y := 2
+	if c {
+		fmt.Println(y)
+	}
+}
+
+func newFinding(c bool) {
+	z := 3 // want "Variable 'z' can be moved to tighter block scope"
+	if c {
+		fmt.Println(z)
+	}
+}
+
+func otherVariable(c bool) {
+	x := 4 // want "Variable 'x' can be moved to tighter block scope"
+	if c {
+		fmt.Println(x)
+	}
+}
+
+func acceptedMultiple(c bool) error {
+	v, err := compute()
+	if c {
+		fmt.Println(v, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package branchinit
+
+import "fmt"
+
+func ifElse(c bool) {
+	var x int // want "Zero value of variable 'x' is overwritten on all branches"
+	if c {
+		x = 1
+	} else {
+		x = 2
+	}
+	fmt.Println(x)
+}
+
+func elseIf(a, b bool) {
+	var x, y int // want "Zero values of variables 'x' and 'y' are overwritten on all branches"
+	if a {
+		x, y = 1, 2
+	} else if b {
+		x, y = 3, 4
+	} else {
+		fmt.Println("none")
+		x = 5
+		y = x
+	}
+	fmt.Println(x, y)
+}
+
+func earlyReturn(c bool) error {
+	var err error // want "Zero value of variable 'err' is overwritten on all branches"
+	if c {
+		err = fmt.Errorf("c")
+	} else {
+		return nil
+	}
+	return err
+}
+
+func nestedIf(a, b bool) {
+	var x int // want "Zero value of variable 'x' is overwritten on all branches"
+	if a {
+		if b {
+			x = 1
+		} else {
+			x = 2
+		}
+	} else {
+		x = 3
+	}
+	fmt.Println(x)
+}
+
+func noElse(c bool) {
+	var x int
+	if c {
+		x = 1
+	}
+	fmt.Println(x)
+}
+
+func readBeforeAssign(c bool) {
+	var x int
+	if c {
+		x = 1
+	} else {
+		x = x + 2
+	}
+	fmt.Println(x)
+}
+
+func usedInCondition() {
+	var x int
+	if x == 0 {
+		x = 1
+	} else {
+		x = 2
+	}
+	fmt.Println(x)
+}
+
+func fieldAssign(c bool) {
+	var p struct{ a, b int }
+	if c {
+		p.a = 1
+	} else {
+		p = struct{ a, b int }{}
+	}
+	fmt.Println(p)
+}
+
+func withInitializer(c bool) {
+	var x = 1
+	if c {
+		x = 2
+	} else {
+		x = 3
+	}
+	fmt.Println(x)
+}
+
+func partially(c bool) {
+	var x, y int // want "Zero value of variable 'x' is overwritten on all branches"
+	if c {
+		x = 1
+	} else {
+		x, y = 2, 3
+	}
+	fmt.Println(x, y)
+}
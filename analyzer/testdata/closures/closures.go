@@ -0,0 +1,66 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package closures
+
+import "fmt"
+
+func closureLocal() func(bool) {
+	return func(c bool) {
+		x := 1
+		if c {
+			fmt.Println(x)
+		}
+	}
+}
+
+func outerUsedInClosure(c bool) {
+	x := 1 // want "Variable 'x' can be moved to tighter block scope"
+	if c {
+		f := func() {
+			y := x
+			fmt.Println(y)
+		}
+		f()
+	}
+}
+
+func outerNotMovable(c bool) {
+	x := 1
+	f := func() { fmt.Println(x) }
+	if c {
+		fmt.Println(x)
+	}
+	f()
+}
+
+func outerAssignedInClosure() {
+	x := 1
+	func() {
+		x = 2
+	}()
+	fmt.Println(x)
+}
+
+func nestedInClosure() {
+	x := 0
+	fmt.Println(x)
+	x = func() int {
+		x = 1 // want "Nested reassignment of variable 'x'"
+		return 2
+	}()
+	fmt.Println(x)
+}
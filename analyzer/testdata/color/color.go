@@ -0,0 +1,33 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package color
+
+import "fmt"
+
+func colored() {
+	x := 1 // want "Variable '\x1b\\[1;36mx\x1b\\[0m' can be moved to tighter \x1b\\[33mif\x1b\\[0m scope \x1b\\[2m\\(sg:mov\\)\x1b\\[0m"
+	if x > 0 {
+		fmt.Println(x)
+	}
+}
+
+func multiple() {
+	x, y := 1, 2 // want "Variables '\x1b\\[1;36mx\x1b\\[0m' and '\x1b\\[1;36my\x1b\\[0m' can be moved to tighter \x1b\\[33mblock\x1b\\[0m scope"
+	{
+		fmt.Println(x, y)
+	}
+}
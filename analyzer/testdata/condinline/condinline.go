@@ -0,0 +1,86 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package condinline
+
+import (
+	"fmt"
+	"strings"
+)
+
+func length(s string) {
+	if n := len(s); n > 0 { // want "Variable 'n' is only used in the if condition and can be inlined"
+		fmt.Println("not empty")
+	}
+}
+
+func call(s string) {
+	if ok := strings.HasPrefix(s, "x"); ok { // want "Variable 'ok' is only used in the if condition and can be inlined"
+		fmt.Println("prefixed")
+	}
+}
+
+func elseIf(s string, a bool) {
+	if a {
+		fmt.Println("a")
+	} else if n := len(s); n > 1 { // want "Variable 'n' is only used in the if condition and can be inlined"
+		fmt.Println("long")
+	}
+}
+
+func usedInBody(s string) {
+	if n := len(s); n > 0 {
+		fmt.Println(n)
+	}
+}
+
+func usedInElse(s string) {
+	if n := len(s); n > 10 {
+		fmt.Println("long")
+	} else if n > 5 {
+		fmt.Println("medium")
+	}
+}
+
+func usedTwice(s string) {
+	if n := len(s); n > 1 && n < 10 {
+		fmt.Println("medium")
+	}
+}
+
+func shortCircuit(s string, a bool) {
+	if ok := strings.HasPrefix(s, "x"); a && ok {
+		fmt.Println("prefixed")
+	}
+}
+
+func shortCircuitInert(a bool) {
+	if n := 3; a || n > 2 { // want "Variable 'n' is only used in the if condition and can be inlined"
+		fmt.Println("yes")
+	}
+}
+
+func closure(s string) {
+	if n := len(s); func() bool { return n > 0 }() {
+		fmt.Println("not empty")
+	}
+}
+
+func multiple(s string) {
+	if n, m := len(s), 1; n > m {
+		fmt.Println("long")
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build go1.21
+
+package conservative
+
+import "fmt"
+
+func minMax(ok bool) {
+	x := 1 // want "Variable 'x' can be moved to tighter if scope"
+	lo, hi := 0, 10
+	limit := max(lo, min(hi, 5))
+	if x > 0 && ok {
+		fmt.Println(limit)
+	}
+}
+
+func minMaxOuter(ok bool, n int) {
+	x := 1
+	limit := max(n, 5)
+	if x > 0 && ok {
+		fmt.Println(limit)
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package deadinit
+
+import "fmt"
+
+func next() int { return 1 }
+
+func pair() (int, string) { return 1, "1" }
+
+// Initial value is overwritten before being read.
+func deadInit() {
+	x := 0 // want "Initial value of variable 'x' is overwritten before being read"
+	x = next()
+	fmt.Println(x)
+}
+
+// Declaration alone on its line is removed with the whole line.
+func deadInitAlone() {
+	// want +1 "Initial value of variable 'x' is overwritten before being read"
+	x := 0
+	x = next()
+	fmt.Println(x)
+}
+
+// All initial values are overwritten before being read.
+func deadInitMulti() {
+	x, y := 0, "" // want "Initial values of variables 'x' and 'y' are overwritten before being read"
+	x, y = pair()
+	fmt.Println(x, y)
+}
+
+// Only some initial values are overwritten - no fix.
+func deadInitPartial() {
+	x, y := 0, 1 // want "Initial value of variable 'x' is overwritten before being read"
+	x = y
+	fmt.Println(x, y)
+}
+
+// The initializer has side effects - no fix.
+func deadInitSideEffect() {
+	x := next() // want "Initial value of variable 'x' is overwritten before being read"
+	x = next()
+	fmt.Println(x)
+}
+
+// Merging would change the inferred type - no fix.
+func deadInitTypeChange() {
+	x := int64(0) // want "Initial value of variable 'x' is overwritten before being read"
+	x = 5
+	fmt.Println(x)
+}
+
+// The initial value is read by the assignment.
+func deadInitRead() {
+	x := 1
+	x = x + next()
+	fmt.Println(x)
+}
+
+// The assignment is conditional.
+func deadInitConditional(c bool) {
+	x := 0
+	if c {
+		x = next()
+	}
+	fmt.Println(x)
+}
+
+// The assignment is not immediately following.
+func deadInitIntervening() {
+	x := 0
+	fmt.Println(x)
+	x = next()
+	fmt.Println(x)
+}
+
+// Suppressed.
+func deadInitNoLint() {
+	x := 0 //nolint:scopeguard
+	x = next()
+	fmt.Println(x)
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package debugprint
+
+import (
+	"fmt"
+	"log"
+)
+
+func compute() int { return 42 }
+
+func printed(cond bool) {
+	x := compute() // want "Variable 'x' can be moved to tighter block scope \\(sg:dbg\\)"
+	fmt.Println("x =", x)
+	if cond {
+		fmt.Println(x + 1)
+	}
+}
+
+func logged(cond bool) {
+	x := compute() // want "Variable 'x' can be moved to tighter block scope \\(sg:dbg\\)"
+	log.Printf("x = %d", x)
+	if cond {
+		_ = x * 2
+	}
+}
+
+func builtin(cond bool) {
+	x := compute() // want "Variable 'x' can be moved to tighter block scope \\(sg:dbg\\)"
+	println(x)
+	if cond {
+		_ = x * 2
+	}
+}
+
+func notDirect(cond bool) {
+	x := compute()
+	fmt.Println(x + 1)
+	if cond {
+		_ = x * 2
+	}
+}
+
+func otherCall(cond bool) {
+	x := compute()
+	_ = fmt.Sprint(x)
+	if cond {
+		_ = x * 2
+	}
+}
+
+func onlyPrinted() {
+	x := compute()
+	fmt.Println(x)
+}
+
+func unprinted(cond bool) {
+	x := compute() // want "Variable 'x' can be moved to tighter block scope \\(sg:mov\\)"
+	if cond {
+		_ = x * 2
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package documented
+
+import "fmt"
+
+func documented(cond bool) {
+	// limit is the maximum number of retries.
+	var limit = 3 // want "Variable 'limit' can be moved to tighter block scope \\(sg:doc\\)"
+	if cond {
+		fmt.Println(limit)
+	}
+}
+
+func undocumented(cond bool) {
+	var limit = 3 // want "Variable 'limit' can be moved to tighter block scope \\(sg:mov\\)"
+	if cond {
+		fmt.Println(limit)
+	}
+}
+
+func shortDecl(cond bool) {
+	// A comment before a short declaration is no doc comment.
+	limit := 3 // want "Variable 'limit' can be moved to tighter block scope \\(sg:mov\\)"
+	if cond {
+		fmt.Println(limit)
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package explainunused
+
+import (
+	"errors"
+	"fmt"
+)
+
+func reassigned() {
+	err := errors.New("first") // want "Variable 'err' is unused and can be removed"
+
+	a, err := 1, errors.New("second") // want "Variables 'a' and 'err' can be moved to tighter if scope"
+	if a == 0 {
+		fmt.Println(a, err)
+	}
+}
+
+func madeUnused() {
+	a, ok := 1, true // want "Variables 'a' and 'ok' can be moved to tighter if scope"
+	if ok {
+		fmt.Println(a)
+	}
+
+	b, ok := 2, false // want "Variable 'ok' is unused and can be removed"
+	fmt.Println(b)
+}
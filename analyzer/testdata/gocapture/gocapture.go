@@ -0,0 +1,95 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gocapture
+
+import (
+	"fmt"
+	"sync"
+)
+
+func compute() int { return 42 }
+
+func captured() {
+	x := compute() // want "Variable 'x' is only used in a goroutine and can be passed as a parameter"
+	go func() {
+		fmt.Println(x)
+	}()
+}
+
+func capturedPair(wg *sync.WaitGroup) {
+	var a, b = compute(), compute() // want "Variables 'a' and 'b' are only used in a goroutine and can be passed as parameters"
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		fmt.Println(a + b)
+	}()
+}
+
+func nestedLiteral() {
+	x := compute() // want "Variable 'x' is only used in a goroutine"
+	go func() {
+		f := func() { fmt.Println(x) }
+		f()
+	}()
+}
+
+func passed() {
+	x := compute()
+	go fmt.Println(x)
+}
+
+func usedOutside() {
+	x := compute()
+	go func() {
+		fmt.Println(x)
+	}()
+	fmt.Println(x)
+}
+
+func twoGoroutines() {
+	x := compute()
+	go func() {
+		fmt.Println(x)
+	}()
+	go func() {
+		fmt.Println(x)
+	}()
+}
+
+func assigned() {
+	x := compute()
+	go func() {
+		x++
+		fmt.Println(x)
+	}()
+}
+
+func addressed() {
+	x := compute()
+	go func() {
+		p := &x
+		fmt.Println(*p)
+	}()
+}
+
+func plainClosure() {
+	x := compute()
+	f := func() {
+		fmt.Println(x)
+	}
+	f()
+}
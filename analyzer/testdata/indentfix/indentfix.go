@@ -0,0 +1,60 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package indentfix
+
+import "fmt"
+
+func nested(a, b bool) {
+	x := 1 // want "Variable 'x' can be moved to tighter block scope"
+	if a {
+		if b {
+			fmt.Println(x)
+		}
+	}
+}
+
+func clause(n int) {
+	y := 2 // want "Variable 'y' can be moved to tighter case scope"
+	switch n {
+	case 1:
+		fmt.Println(y)
+		fmt.Println(y)
+	}
+}
+
+func multiline(ok bool) {
+	p := struct { // want "Variable 'p' can be moved to tighter block scope"
+		a, b int
+	}{
+		a: 1,
+		b: 2,
+	}
+	if ok {
+		fmt.Println(p)
+		fmt.Println(p)
+	}
+}
+
+func raw(ok bool) {
+	s := fmt.Sprint( // want "Variable 's' can be moved to tighter block scope"
+		`first
+second`)
+	if ok {
+		fmt.Println(s)
+		fmt.Println(s)
+	}
+}
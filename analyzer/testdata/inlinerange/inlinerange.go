@@ -0,0 +1,112 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package inlinerange
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+)
+
+func keys(m map[string]int) {
+	keys := maps.Keys(m) // want "Variable 'keys' is only used as a range expression and can be inlined"
+	for k := range keys {
+		fmt.Println(k)
+	}
+}
+
+type list struct{ items []string }
+
+func (l *list) all() *list       { return l }
+func (l *list) values() []string { return l.items }
+
+func chain(l *list) {
+	values := l.all().values() // want "Variable 'values' is only used as a range expression and can be inlined"
+	for _, v := range values {
+		fmt.Println(v)
+	}
+}
+
+func constant() {
+	n := 10 // want "Variable 'n' is only used as a range expression and can be inlined"
+	for i := range n {
+		fmt.Println(i)
+	}
+}
+
+func labeled(s string) {
+	fields := strings.Fields(s) // want "Variable 'fields' is only used as a range expression and can be inlined"
+outer:
+	for _, f := range fields {
+		if f == "" {
+			break outer
+		}
+	}
+}
+
+func usedInBody(s string) {
+	fields := strings.Fields(s)
+	for i := range fields {
+		fmt.Println(fields[i])
+	}
+}
+
+func usedAfter(s string) {
+	fields := strings.Fields(s)
+	for _, f := range fields {
+		fmt.Println(f)
+	}
+
+	fmt.Println(len(fields))
+}
+
+func notAdjacent(s string) {
+	fields := strings.Fields(s)
+	fmt.Println(s)
+
+	for _, f := range fields {
+		fmt.Println(f)
+	}
+}
+
+func nestedCall(s string) {
+	fields := strings.Fields(strings.TrimSpace(s))
+	for _, f := range fields {
+		fmt.Println(f)
+	}
+}
+
+func runeConstant() {
+	r := 'a'
+	for i := range r {
+		fmt.Println(i)
+	}
+}
+
+func conversion(s string) {
+	b := []byte(s)
+	for _, c := range b {
+		fmt.Println(c)
+	}
+}
+
+func nolint(s string) {
+	fields := strings.Fields(s) //nolint:scopeguard
+	for _, f := range fields {
+		fmt.Println(f)
+	}
+}
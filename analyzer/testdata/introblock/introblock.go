@@ -0,0 +1,129 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package introblock
+
+import "fmt"
+
+func read(buf []byte) int { return len(buf) }
+
+func process(b []byte) { fmt.Println(len(b)) }
+
+func finish() error { return nil }
+
+func basic() error {
+	buf := make([]byte, 64) // want "Variables 'buf' and 'n' can be scoped by introducing a block"
+	n := read(buf)
+	process(buf[:n])
+
+	return finish()
+}
+
+func untilEnd() {
+	buf := make([]byte, 64)
+	n := read(buf)
+	process(buf[:n])
+}
+
+func usedAfter() error {
+	a := 1
+	b := a // want "Variable 'b' can be scoped by introducing a block"
+	fmt.Println(a)
+	fmt.Println(b)
+
+	return finish()
+}
+
+func labeled() error {
+	i := 0
+loop:
+	if i < 3 {
+		i++
+		goto loop
+	}
+
+	return finish()
+}
+
+func nested() error {
+	x := 1     // want "Variable 'x' can be scoped by introducing a block"
+	y := x + 1 // want "Variable 'y' can be scoped by introducing a block"
+	fmt.Println(y)
+	fmt.Println(x)
+
+	return finish()
+}
+
+func trailingComment() error {
+	s := "text"    // want "Variable 's' can be scoped by introducing a block"
+	fmt.Println(s) // print it
+
+	return finish()
+}
+
+func clause(k int) {
+	switch k {
+	case 1:
+		v := k * 2 // want "Variable 'v' can be scoped by introducing a block"
+		fmt.Println(v)
+		_ = finish()
+	}
+}
+
+func varDecl() error {
+	var total int // want "Variable 'total' can be scoped by introducing a block"
+	for i := range 3 {
+		total += i
+	}
+	fmt.Println(total)
+
+	return finish()
+}
+
+func rawString() error { // want +1 "Variable 's' can be scoped by introducing a block"
+	s := `first
+second`
+	fmt.Println(s)
+
+	return finish()
+}
+
+func pair() (int, error) { return 1, nil }
+
+// Reused variables of short declarations would be shadowed in the new block.
+func reusedLocal() error {
+	err := finish()
+	x, err := pair()
+	fmt.Println(x)
+	fmt.Println("done")
+
+	return err
+}
+
+func reusedResult() (err error) {
+	x, err := pair()
+	fmt.Println(x)
+	fmt.Println("done")
+
+	return
+}
+
+func noLint() error {
+	s := "text" //nolint:scopeguard
+	fmt.Println(s)
+
+	return finish()
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package join
+
+import "fmt"
+
+func joined() {
+	// want +1 "Variables 'a' and 'b' can be joined into a single declaration"
+	a := 1
+	b := "b"
+	fmt.Println(a, b)
+}
+
+func three() {
+	// want +1 "Variables 'x', 'y' and 'z' can be joined into a single declaration"
+	x, y := 1, 2
+	z := 3.0
+	fmt.Println(x, y, z)
+}
+
+func computed() {
+	a := 1
+	b := fmt.Sprint(a)
+	fmt.Println(a, b)
+}
+
+func separated() {
+	a := 1
+
+	b := 2
+	fmt.Println(a, b)
+}
+
+func trailing() {
+	a := 1 // The count.
+	b := "b"
+	fmt.Println(a, b)
+}
+
+func documented() {
+	// The count.
+	a := 1
+	// The name.
+	b := "b"
+	fmt.Println(a, b)
+}
+
+func interrupted() {
+	a := 1
+	fmt.Println(a)
+	b := 2
+	fmt.Println(b)
+}
+
+func reassigned() {
+	a := 1
+	a, b := 2, 3
+	fmt.Println(a, b)
+}
+
+func moved(cond bool) {
+	a := 1
+	b := 2 // want "Variable 'b' can be moved to tighter block scope"
+	if cond {
+		fmt.Println(b)
+	}
+	fmt.Println(a)
+}
+
+func nolint() {
+	a := 1 //nolint:scopeguard
+	b := 2
+	fmt.Println(a, b)
+}
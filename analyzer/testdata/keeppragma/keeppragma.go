@@ -0,0 +1,55 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeppragma
+
+import "fmt"
+
+func pragma(ok bool) {
+	x := 1 //keep:top
+	if ok {
+		fmt.Println(x)
+	}
+}
+
+func explained(ok bool) {
+	x := 1 // keep:top read by the deferred logging below
+	if ok {
+		fmt.Println(x)
+	}
+}
+
+func other(ok bool) {
+	x := 1 //keep:here
+	if ok {
+		fmt.Println(x)
+	}
+}
+
+func prefix(ok bool) {
+	x := 1 // want "Variable 'x' can be moved to tighter block scope"
+	//keep:top
+	if ok {
+		fmt.Println(x)
+	}
+}
+
+func similar(ok bool) {
+	x := 1 //keep:topmost // want "Variable 'x' can be moved to tighter block scope"
+	if ok {
+		fmt.Println(x)
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package loopbody
+
+import "fmt"
+
+func next() int { return 1 }
+
+func rangeBody(values []int) {
+	limit := 10 // want "Variable 'limit' can be moved to tighter block scope"
+	for _, v := range values {
+		fmt.Println(min(v, limit))
+	}
+}
+
+func forBody() {
+	var prefix = "item" // want "Variable 'prefix' can be moved to tighter block scope"
+	for i := 0; i < 3; i++ {
+		fmt.Println(prefix, i)
+	}
+}
+
+func nonConstant(values []int) {
+	limit := next()
+	for _, v := range values {
+		fmt.Println(min(v, limit))
+	}
+}
+
+func reassigned(values []int) {
+	sum := 0
+	for _, v := range values {
+		sum += v
+		fmt.Println(sum)
+	}
+}
+
+func addressTaken(values []int) {
+	count := 0
+	for range values {
+		p := &count
+		fmt.Println(*p)
+	}
+}
+
+func closure(values []int) {
+	limit := 10 // want "Variable 'limit' can be moved to tighter block scope"
+	for _, v := range values {
+		func() {
+			fmt.Println(min(v, limit))
+		}()
+	}
+}
+
+func funcLit(values []int) func() int {
+	limit := 10
+	return func() int {
+		for range values {
+			fmt.Println(limit)
+		}
+		return 0
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package loopbody
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSubtests(t *testing.T) {
+	prefix := "case"
+
+	tests := []struct{ name string }{{name: "a"}, {name: "b"}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !strings.HasPrefix(prefix+tt.name, prefix) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func BenchmarkSubtests(b *testing.B) {
+	const n = 3
+
+	size := 10
+
+	for range n {
+		b.Run("size", func(b *testing.B) {
+			_ = make([]int, size)
+		})
+	}
+}
+
+func TestNoSubtest(t *testing.T) {
+	limit := 2 // want "Variable 'limit' can be moved to tighter block scope"
+	for _, v := range []int{1, 2, 3} {
+		t.Log(min(v, limit))
+	}
+}
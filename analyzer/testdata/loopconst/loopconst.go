@@ -0,0 +1,104 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package loopconst
+
+import "fmt"
+
+func bound() {
+	limit := 10 // want "Variable 'limit' bounding a loop is never reassigned and can be a constant"
+	for i := 0; i < limit; i++ {
+		fmt.Println(i)
+	}
+}
+
+func typed() {
+	top := int64(3) // want "Variable 'top' bounding a loop is never reassigned and can be a constant"
+	for i := int64(0); i < top; i++ {
+		fmt.Println(i, top)
+	}
+}
+
+func condOnly(n int) {
+	step := 2 // want "Variable 'step' bounding a loop is never reassigned and can be a constant"
+	for n > step {
+		n -= step
+	}
+}
+
+func reassigned() {
+	limit := 10
+	for i := 0; i < limit; i++ {
+		if i == 5 {
+			limit = 7
+		}
+	}
+}
+
+func incremented() {
+	limit := 10
+	for i := 0; i < limit; i++ {
+		limit--
+	}
+}
+
+func addressTaken() {
+	limit := 10
+	p := &limit
+	for i := 0; i < limit; i++ {
+		fmt.Println(*p)
+	}
+}
+
+func notInCond() {
+	limit := 10
+	for i := range limit {
+		fmt.Println(i)
+	}
+}
+
+func notConstant(n int) {
+	limit := n
+	for i := 0; i < limit; i++ {
+		fmt.Println(i)
+	}
+}
+
+func nonBasic() {
+	limit := [2]int{}
+	for i := 0; i < len(limit); i++ {
+		fmt.Println(i)
+	}
+}
+
+type Limit int
+
+func (l *Limit) Shrink() { *l-- }
+
+func pointerMethod() {
+	limit := Limit(10)
+	for i := 0; i < int(limit); i++ {
+		limit.Shrink()
+	}
+}
+
+func rangeAssigned(xs []int) {
+	limit := 10
+	for i := 0; i < limit; i++ {
+		for _, limit = range xs {
+		}
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package loopconstreport
+
+import "fmt"
+
+// Without suggested fixes, loop bounds are reported as movable, too.
+func nested(c bool) {
+	limit := 10 // want "Variable 'limit' can be moved to tighter block scope" "Variable 'limit' bounding a loop is never reassigned and can be a constant"
+	if c {
+		for i := 0; i < limit; i++ {
+			fmt.Println(i)
+		}
+	}
+}
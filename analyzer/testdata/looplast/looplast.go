@@ -0,0 +1,105 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package looplast
+
+import "fmt"
+
+func rangeLast(xs []int) {
+	var last int // want "Variable 'last' only holds the last value assigned in the loop"
+	for _, x := range xs {
+		last = x
+	}
+	fmt.Println(last)
+}
+
+func forLast(n int) {
+	var last, square int // want "Variables 'last' and 'square' only hold the last values assigned in the loop"
+	for i := 0; i < n; i++ {
+		last, square = i, i*i
+	}
+	fmt.Println(last, square)
+}
+
+func lastMatching(xs []string) string {
+	var found string // want "Variable 'found' only holds the last value assigned in the loop"
+	for _, x := range xs {
+		if x != "" {
+			found = x
+		}
+	}
+	return found
+}
+
+func accumulated(xs []int) {
+	var sum int
+	for _, x := range xs {
+		sum += x
+	}
+	fmt.Println(sum)
+}
+
+func readInLoop(xs []int) {
+	var prev int
+	for _, x := range xs {
+		fmt.Println(prev)
+		prev = x
+	}
+	fmt.Println(prev)
+}
+
+func readTwice(xs []int) {
+	var last int
+	for _, x := range xs {
+		last = x
+	}
+	fmt.Println(last, last)
+}
+
+func reassignedAfter(xs []int) {
+	var last int
+	for _, x := range xs {
+		last = x
+	}
+	fmt.Println(last)
+	last = 0
+	_ = last
+}
+
+func usedInHeader(n int) {
+	var last int
+	for i := 0; i < n && last < 10; i++ {
+		last = i
+	}
+	fmt.Println(last)
+}
+
+func withInitializer(xs []int) {
+	var last = -1
+	for _, x := range xs {
+		last = x
+	}
+	fmt.Println(last)
+}
+
+func notFollowedByLoop(xs []int) {
+	var last int
+	fmt.Println(len(xs))
+	for _, x := range xs {
+		last = x
+	}
+	fmt.Println(last)
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package loopshadow
+
+import "fmt"
+
+func process(v int) int { return v + 1 }
+
+func loopShadow() {
+	for i := 0; i < 10; i++ {
+		i := 1 // want "Declaration of 'i' shadows the loop variable"
+		fmt.Println(i)
+	}
+}
+
+func loopShadowVar() {
+	for i := 0; i < 10; i++ {
+		var i = "one" // want "Declaration of 'i' shadows the loop variable"
+		fmt.Println(i)
+	}
+}
+
+func rangeRedeclare(s []int) {
+	for _, v := range s {
+		v := process(v)
+		fmt.Println(v)
+	}
+}
+
+func nestedBlock() {
+	for i := 0; i < 10; i++ {
+		if i > 5 {
+			i := 1
+			fmt.Println(i)
+		}
+	}
+}
+
+func otherName() {
+	for i := 0; i < 10; i++ {
+		j := i
+		fmt.Println(j)
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maxfuncsize
+
+import "fmt"
+
+func small(cond bool) {
+	x := 1 // want "Variable 'x' can be moved to tighter block scope"
+	if cond {
+		fmt.Println(x)
+	}
+}
+
+// Statements of function literals in package level declarations don't count for preceding functions.
+var _ = func() {
+	fmt.Println()
+	fmt.Println()
+	fmt.Println()
+}
+
+func large(cond bool) { // want "Function 'large' is not analyzed, it has more than 4 statements"
+	x := 1
+	if cond {
+		fmt.Println(x)
+	}
+	fmt.Println()
+	fmt.Println()
+}
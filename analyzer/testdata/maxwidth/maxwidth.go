@@ -0,0 +1,37 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maxwidth
+
+import "fmt"
+
+// The combined initializer fits the width.
+func narrow() {
+	a := 1 // want "Variable 'a' can be moved to tighter if scope"
+	b := 2 // want "Variable 'b' can be moved to tighter if scope"
+	if a < b {
+		fmt.Println("less")
+	}
+}
+
+// The combined initializer exceeds the width, the conflict is reported without a fix.
+func wide() {
+	first := 1  // want "Variable 'first' can be moved to tighter if scope"
+	second := 2 // want "Variable 'second' can be moved to tighter if scope"
+	if first < second {
+		fmt.Println("less")
+	}
+}
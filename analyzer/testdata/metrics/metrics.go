@@ -0,0 +1,53 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import "fmt"
+
+func findings(cond bool) {
+	a := 1 // want "Variable 'a' can be moved to tighter if scope"
+	b := 2 // want "Variable 'b' can be moved to tighter if scope"
+	if a > b {
+		fmt.Println(a, b)
+	}
+
+	x := 3 // want "Variable 'x' can be moved to tighter block scope"
+	if cond {
+		fmt.Println(x)
+	}
+
+	i, c := -1, true
+
+	if c {
+		i := -i
+		fmt.Println(i)
+	}
+
+	i, d := i-1, true // want "Identifier 'i' used after previously shadowed"
+	fmt.Println(i, d)
+
+	var err error
+	err = func() error {
+		err = error(nil) // want "Nested reassignment of variable 'err'"
+		return err
+	}()
+	fmt.Println(err)
+}
+
+func empty() {
+	fmt.Println()
+}
@@ -0,0 +1,36 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package minspan
+
+import "fmt"
+
+func short() {
+	x := 1
+	if true {
+		fmt.Println(x)
+	}
+}
+
+func long() {
+	x := 1 // want "Variable 'x' can be moved to tighter block scope"
+	if true {
+		fmt.Println("one")
+		fmt.Println("two")
+		fmt.Println("three")
+		fmt.Println(x)
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package movable
+
+import "fmt"
+
+func movable(c bool) {
+	x := 1 // want "Variable 'x' can be moved to tighter block scope"
+	if c {
+		fmt.Println(x)
+	}
+}
+
+func notMovable(c bool) {
+	x := 1
+	if c {
+		fmt.Println(x)
+	}
+	fmt.Println(x)
+}
+
+func combined() {
+	a := 1 // want "Variable 'a' can be moved to tighter if scope"
+	b := 2
+	if a < b {
+		fmt.Println("less")
+	}
+}
+
+func nested() {
+	f := func(c bool) {
+		y := "nested" // want "Variable 'y' can be moved to tighter block scope"
+		if c {
+			fmt.Println(y)
+		}
+	}
+	f(true)
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package nofix
+
+import "fmt"
+
+// Accumulator written in a range loop and read after - must stay above the loop.
+func accumulateRange(xs []int) {
+	sum := 0
+	for i := range xs {
+		sum += xs[i]
+	}
+
+	if sum > 0 {
+		fmt.Println(sum)
+	}
+}
+
+// Accumulator written in a for loop and read after - must stay above the loop.
+func accumulateFor(xs []int) {
+	sum := 0
+	for i := 0; i < len(xs); i++ {
+		sum = sum + xs[i]
+	}
+
+	if sum > 0 {
+		fmt.Println(sum)
+	}
+}
+
+// Accumulator written in a nested loop body block and read after.
+func accumulateNested(xs [][]int) {
+	count := 0
+	for _, x := range xs {
+		if len(x) > 0 {
+			count++
+		}
+	}
+
+	if count > 0 {
+		fmt.Println(count)
+	}
+}
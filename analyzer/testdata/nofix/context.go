@@ -0,0 +1,64 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package nofix
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+func contextCancel(parent context.Context, ok bool) {
+	ctx, cancel := context.WithCancel(parent)
+	if ok {
+		defer cancel()
+		fmt.Println(ctx.Err())
+	}
+}
+
+func contextTimeout(parent context.Context, ok bool) {
+	ctx, cancel := context.WithTimeout(parent, time.Second)
+	if ok {
+		defer cancel()
+		fmt.Println(ctx.Err())
+	}
+}
+
+func contextDeadlineCause(parent context.Context, ok bool) {
+	ctx, cancel := context.WithDeadlineCause(parent, time.Now(), context.Canceled)
+	if ok {
+		defer cancel()
+		fmt.Println(ctx.Err())
+	}
+}
+
+func contextDiscarded(parent context.Context, ok bool) {
+	ctx, _ := context.WithCancel(parent) // want "Variable 'ctx' can be moved to tighter block scope"
+	if ok {
+		fmt.Println(ctx.Err())
+	}
+}
+
+func contextShadowed(parent context.Context) {
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+	{
+		ctx := context.WithoutCancel(ctx)
+		fmt.Println(ctx.Err())
+	}
+	fmt.Println(ctx.Err()) // want "Identifier 'ctx' used after previously shadowed"
+}
@@ -52,3 +52,25 @@ func nestedOk() {
 
 	_ = err
 }
+
+// Compound assignments (+=, -=, ...) are not tracked as nested assignments.
+func nestedCompound(m map[string]int, k string) {
+	n := 0
+
+	n += func() int {
+		n = 1
+		return n
+	}()
+
+	n = func() int {
+		n += 1
+		return n
+	}()
+
+	m[k] += func() int {
+		n -= m[k]
+		return n
+	}()
+
+	_ = n
+}
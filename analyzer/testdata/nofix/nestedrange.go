@@ -0,0 +1,41 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package nofix
+
+import "fmt"
+
+// The declaration is only used in the inner body, but must stay above the outer range loop.
+func nestedRange(rows, cols []int) {
+	sep := ", "
+	for _, r := range rows {
+		for _, c := range cols {
+			fmt.Print(r, sep, c)
+		}
+	}
+}
+
+// The inner range loop is nested in a block of the outer loop body.
+func nestedRangeBlock(rows, cols []int) {
+	sep := ", "
+	for _, r := range rows {
+		if r > 0 {
+			for _, c := range cols {
+				fmt.Print(r, sep, c)
+			}
+		}
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package nofix
+
+import "fmt"
+
+func parenNested() {
+	var err error
+
+	{
+		err := error(nil)
+		_ = err
+	}
+
+	(err) = func() error {
+		(err) = error(nil) // want "Nested reassignment of variable 'err'"
+		return err
+	}()
+
+	_ = err
+}
+
+func parenShadowCleared() {
+	x := 1
+	fmt.Println(x)
+
+	{
+		x := 2
+		fmt.Println(x)
+	}
+
+	(x) = 3
+	fmt.Println(x)
+}
+
+func parenShadowUsed() {
+	x := 1
+	fmt.Println(x)
+
+	{
+		x := 2
+		fmt.Println(x)
+	}
+
+	(x) = x + 1 // want "Identifier 'x' used after previously shadowed"
+	fmt.Println(x)
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package nofix
+
+import "fmt"
+
+func recursiveClosure(n int) {
+	var fact func(int) int
+	if n > 0 {
+		fact = func(k int) int {
+			if k <= 1 {
+				return 1
+			}
+
+			return k * fact(k-1)
+		}
+		fmt.Println(fact(n))
+	}
+}
+
+func recursiveClosureGroup(n int) {
+	var (
+		even func(int) bool
+		odd  func(int) bool
+	)
+	if n > 0 {
+		even = func(k int) bool { return k == 0 || odd(k-1) }
+		odd = func(k int) bool { return k != 0 && even(k-1) }
+		fmt.Println(even(n))
+	}
+}
+
+func nonRecursiveClosure(n int) {
+	var double func(int) int // want "Variable 'double' can be moved to tighter block scope"
+	if n > 0 {
+		double = func(k int) int { return 2 * k }
+		fmt.Println(double(n))
+	}
+}
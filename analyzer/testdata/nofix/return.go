@@ -21,10 +21,10 @@ import "fmt"
 func recoveredReturn() {
 	f := func() (int, bool) { return 1, true }
 
-	// This function has a named result parameter, but the usage is not detected
+	// This function has a named result parameter returned after the recovered panic
 	v := func() (r int) {
 		defer func() { _ = recover() }()
-		r, ok := f() //nolint:scopeguard this would be moved
+		r, ok := f()
 		if ok {
 			_ = r // use r
 		}
@@ -0,0 +1,68 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package nofix
+
+import "fmt"
+
+// Declarations in switch initializers used in several cases stay in the initializer.
+func switchInitCases(n int) {
+	switch x := n * 2; n {
+	case 1:
+		fmt.Println(x)
+	case 2:
+		fmt.Println(x + 1)
+	}
+}
+
+func switchInitTagless(n int) {
+	switch x := n * 2; {
+	case n > 0:
+		fmt.Println(x)
+	default:
+		fmt.Println(-x)
+	}
+}
+
+func switchInitCaseExpressions(n int) {
+	switch limit := 10; {
+	case n < limit:
+		fmt.Println("below")
+	case n > limit:
+		fmt.Println("above")
+	}
+}
+
+func typeSwitchInitCases(v any) {
+	switch prefix := "value"; v := v.(type) {
+	case int:
+		fmt.Println(prefix, v)
+	case string:
+		fmt.Println(prefix, v)
+	}
+}
+
+func switchInitNestedCases(n, m int) {
+	switch x := n * 2; n {
+	case 1:
+		switch m {
+		case 1:
+			fmt.Println(x)
+		}
+	case 2:
+		fmt.Println(x)
+	}
+}
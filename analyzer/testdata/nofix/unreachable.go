@@ -0,0 +1,45 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package nofix
+
+import "fmt"
+
+func unreachableReturn(c bool) {
+	x := 1
+	if c {
+		return
+		fmt.Println(x)
+	}
+}
+
+func unreachablePanic(c bool) {
+	x := 1
+	if c {
+		panic("unreachable")
+		fmt.Println(x)
+	}
+}
+
+func unreachableContinue(items []int) {
+	for _, i := range items {
+		x := i * 2
+		if i > 0 {
+			continue
+			fmt.Println(x)
+		}
+	}
+}
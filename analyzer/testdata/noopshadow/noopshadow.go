@@ -0,0 +1,102 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package noopshadow
+
+import (
+	"fmt"
+	"math"
+)
+
+type counter struct{ n int }
+
+func (c *counter) inc() { c.n++ }
+
+func redundant(x int, cond bool) {
+	if cond {
+		x := x // want "Variable 'x' is redeclared as itself and can be removed"
+		fmt.Println(x)
+	}
+}
+
+func several(a, b string, cond bool) {
+	if cond {
+		a, b := a, b // want "Variables 'a' and 'b' are redeclared as themselves and can be removed"
+		fmt.Println(a, b)
+	}
+}
+
+func parenthesized(s []int, cond bool) {
+	if cond {
+		s := (s) // want "Variable 's' is redeclared as itself and can be removed"
+		s[0] = 1
+		fmt.Println(s)
+	}
+}
+
+func transformed(v float64, cond bool) {
+	if cond {
+		v := math.Abs(v)
+		fmt.Println(v)
+	}
+}
+
+func asserted(x any, cond bool) {
+	if cond {
+		x := x.(int)
+		fmt.Println(x)
+	}
+}
+
+func address(x int, cond bool) {
+	if cond {
+		x := &x
+		fmt.Println(x)
+	}
+}
+
+func reassigned(x int, cond bool) {
+	if cond {
+		x := x
+		x++
+		fmt.Println(x)
+	}
+}
+
+func captured(xs []int) []func() {
+	var fns []func()
+	for _, x := range xs {
+		x := x
+		fns = append(fns, func() { fmt.Println(x) })
+	}
+
+	return fns
+}
+
+func method(c counter, cond bool) {
+	if cond {
+		c := c
+		c.inc()
+		fmt.Println(c)
+	}
+}
+
+func nolint(x int, cond bool) {
+	if cond {
+		x := x //nolint:scopeguard
+		fmt.Println(x)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package onlyerrors
+
+import (
+	"errors"
+	"fmt"
+)
+
+type myError struct{}
+
+func (*myError) Error() string { return "mine" }
+
+func compute() (int, error) { return 1, errors.New("failed") }
+
+func errMoved(cond bool) {
+	err := errors.New("failed") // want "Variable 'err' can be moved to tighter block scope"
+	if cond {
+		fmt.Println(err)
+	}
+}
+
+func pairMoved() {
+	v, err := compute() // want "Variables 'v' and 'err' can be moved to tighter if scope"
+	if err != nil {
+		fmt.Println(v)
+	}
+}
+
+func errorTypeMoved(cond bool) {
+	failure := &myError{} // want "Variable 'failure' can be moved to tighter block scope"
+	if cond {
+		fmt.Println(failure)
+	}
+}
+
+func otherSkipped(cond bool) {
+	x := 1
+	if cond {
+		fmt.Println(x)
+	}
+}
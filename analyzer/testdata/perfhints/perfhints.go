@@ -0,0 +1,81 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package perfhints
+
+import (
+	"fmt"
+	"strings"
+)
+
+func ifBody(s string, ok bool) {
+	up := strings.ToUpper(s) // want "Variable 'up' can be moved to tighter block scope, evaluating the initializer only when needed"
+	if ok {
+		fmt.Println(up)
+	}
+}
+
+func elseBody(s string, ok bool) {
+	var up = strings.ToUpper(s) // want "Variable 'up' can be moved to tighter block scope, evaluating the initializer only when needed"
+	if ok {
+		fmt.Println("ok")
+	} else {
+		fmt.Println(up)
+	}
+}
+
+func caseClause(s string, k int) {
+	up := strings.ToUpper(s) // want "Variable 'up' can be moved to tighter case scope, evaluating the initializer only when needed"
+	switch k {
+	case 1:
+		fmt.Println(up)
+	}
+}
+
+func initField(s string) {
+	up := strings.ToUpper(s) // want "Variable 'up' can be moved to tighter if scope \\("
+	if up != "" {
+		fmt.Println(up)
+	}
+}
+
+func plainBlock(s string) {
+	up := strings.ToUpper(s) // want "Variable 'up' can be moved to tighter block scope \\("
+	{
+		fmt.Println(up)
+	}
+}
+
+func noCall(s string, ok bool) {
+	n := len(s) // want "Variable 'n' can be moved to tighter block scope \\("
+	if ok {
+		fmt.Println(n)
+	}
+}
+
+func conversion(s string, ok bool) {
+	b := []byte(s) // want "Variable 'b' can be moved to tighter block scope \\("
+	if ok {
+		fmt.Println(b)
+	}
+}
+
+func funcLit(ok bool) {
+	f := func() string { return strings.ToUpper("x") } // want "Variable 'f' can be moved to tighter block scope \\("
+	if ok {
+		fmt.Println(f())
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package preferblock
+
+import "fmt"
+
+func condition(s string) {
+	n := len(s)
+	if n > 0 {
+		fmt.Println(n)
+	}
+}
+
+func body(s string, ok bool) {
+	n := len(s) // want "Variable 'n' can be moved to tighter block scope"
+	if ok {
+		fmt.Println(n)
+	}
+}
+
+func nestedBody(s string, ok bool) {
+	n := len(s) // want "Variable 'n' can be moved to tighter block scope"
+	if ok {
+		if n > 0 {
+			fmt.Println(n)
+		}
+	}
+}
+
+func switchCase(s string, k int) {
+	n := len(s) // want "Variable 'n' can be moved to tighter case scope"
+	switch k {
+	case 1:
+		fmt.Println(n)
+	}
+}
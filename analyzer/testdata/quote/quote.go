@@ -0,0 +1,46 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package quote
+
+func compute() int { return 42 }
+
+func moved(cond bool) {
+	x, y := compute(), compute() // want "Variables `x` and `y` can be moved to tighter block scope"
+	if cond {
+		_, _ = x, y
+	}
+}
+
+func shadowed() {
+	x := 1
+	{
+		x := 2
+		_ = x
+	}
+	_ = x // want "Identifier `x` used after previously shadowed"
+}
+
+func nested() {
+	var err error
+
+	err = func() error {
+		err = nil // want "Nested reassignment of variable `err`"
+		return err
+	}()
+
+	_ = err
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rangeshadow
+
+import "fmt"
+
+type celsius float64
+
+func adjust(v int) int { return v + 1 }
+
+func rangeShadow(xs []int) {
+	for i, x := range xs {
+		i := adjust(i) // want "Declaration of 'i' shadows the range variable"
+		fmt.Println(i, x)
+	}
+}
+
+func rangeShadowValue(xs []int) {
+	for _, x := range xs {
+		var x = adjust(x) // want "Declaration of 'x' shadows the range variable"
+		fmt.Println(x)
+	}
+}
+
+func rangeConversion(xs []float64) {
+	for _, x := range xs {
+		x := celsius(x)
+		fmt.Println(x)
+	}
+}
+
+func rangeNestedBlock(xs []int) {
+	for _, x := range xs {
+		if x > 0 {
+			x := adjust(x)
+			fmt.Println(x)
+		}
+	}
+}
+
+func rangeAssign(xs []int) {
+	var x int
+	for _, x = range xs {
+		x := adjust(x)
+		fmt.Println(x)
+	}
+	fmt.Println(x)
+}
+
+func forLoop() {
+	for i := 0; i < 10; i++ {
+		i := adjust(i)
+		fmt.Println(i)
+	}
+}
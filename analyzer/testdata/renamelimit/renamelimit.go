@@ -0,0 +1,37 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package renamelimit
+
+var x_1, x_2 = 0, 0
+
+func exhausted() {
+	x := 1
+	{
+		x := 2
+		_ = x
+	}
+	_ = x // want "Identifier 'x' used after previously shadowed"
+}
+
+func withinLimit() {
+	y := 1
+	{
+		y := 2
+		_ = y
+	}
+	_ = y // want "Identifier 'y' used after previously shadowed"
+}
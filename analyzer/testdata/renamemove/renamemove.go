@@ -0,0 +1,49 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package renamemove
+
+import "fmt"
+
+func compute() int { return 42 }
+
+// The rename does not overlap the move, both are fixed.
+func moveAndRename(cond bool) {
+	x := 1
+	{
+		x := 2
+		fmt.Println(x)
+	}
+	fmt.Println(x) // want "Identifier 'x' used after previously shadowed"
+
+	y := compute() // want "Variable 'y' can be moved to tighter block scope"
+	if cond {
+		fmt.Println(y)
+	}
+}
+
+// The moved declaration uses the shadowed variable, the rename is suppressed.
+func moveConflictsRename(cond bool) {
+	x := 1
+	{
+		x := 2
+		fmt.Println(x)
+	}
+	y := x // want "Identifier 'x' used after previously shadowed" "Variable 'y' can be moved to tighter block scope"
+	if cond {
+		fmt.Println(y)
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reportblocked
+
+import "fmt"
+
+func compute() int { return 42 }
+
+func work() {}
+
+func sideEffects(ok bool) {
+	x := compute() // want "Variable 'x' can be moved to tighter block scope \\(sg:xst\\)"
+	work()
+	if ok {
+		fmt.Println(x)
+	}
+}
+
+func movable() {
+	x := compute() // want "Variable 'x' can be moved to tighter if scope \\(sg:mov\\)"
+	if x > 0 {
+		fmt.Println(x)
+	}
+}
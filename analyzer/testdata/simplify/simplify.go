@@ -0,0 +1,114 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simplify
+
+import (
+	"fmt"
+	"time"
+)
+
+func redundantType(s []string, ok bool) {
+	var n int = len(s) // want "Variable 'n' can be moved to tighter block scope"
+	if ok {
+		fmt.Println(n)
+	}
+}
+
+func multipleNames(ok bool) {
+	var a, b string = "a", "b" // want "Variables 'a' and 'b' can be moved to tighter block scope"
+	if ok {
+		fmt.Println(a, b)
+	}
+}
+
+func differentType(ok bool) {
+	var f float64 = 1 // want "Variable 'f' can be moved to tighter block scope"
+	if ok {
+		fmt.Println(f)
+	}
+}
+
+func interfaceType(ok bool) {
+	var s fmt.Stringer = stringer{} // want "Variable 's' can be moved to tighter block scope"
+	if ok {
+		fmt.Println(s)
+	}
+}
+
+func noValue(ok bool) {
+	var i int // want "Variable 'i' can be moved to tighter block scope"
+	if ok {
+		fmt.Println(i)
+	}
+}
+
+func typedConstant(ok bool) {
+	var d time.Duration = time.Second // want "Variable 'd' can be moved to tighter block scope"
+	if ok {
+		fmt.Println(d)
+	}
+}
+
+type (
+	ints     []int
+	aliasInt = int
+)
+
+func namedInts() ints { return ints{1} }
+
+func namedResult(ok bool) {
+	var s []int = namedInts() // want "Variable 's' can be moved to tighter block scope"
+	if ok {
+		fmt.Println(s)
+	}
+}
+
+func channelDirection(ok bool) {
+	var ch <-chan int = make(chan int) // want "Variable 'ch' can be moved to tighter block scope"
+	if ok {
+		fmt.Println(<-ch)
+	}
+}
+
+func twoInts() (int, int) { return 1, 2 }
+
+func tupleResult(ok bool) {
+	var a, b int = twoInts() // want "Variables 'a' and 'b' can be moved to tighter block scope"
+	if ok {
+		fmt.Println(a, b)
+	}
+}
+
+func twoNamedInts() (ints, ints) { return ints{1}, ints{2} }
+
+func tupleNamedResult(ok bool) {
+	var a, b []int = twoNamedInts() // want "Variables 'a' and 'b' can be moved to tighter block scope"
+	if ok {
+		fmt.Println(a, b)
+	}
+}
+
+func aliasType(ok bool) {
+	var n aliasInt = len("a") // want "Variable 'n' can be moved to tighter block scope"
+	if ok {
+		fmt.Println(n)
+	}
+}
+
+type stringer struct{}
+
+func (stringer) String() string { return "" }
@@ -0,0 +1,100 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package swap
+
+import "fmt"
+
+func compute() int { return 42 }
+
+func logln(s string) { fmt.Println(s) }
+
+func inert() {
+	x := 1 // want "Variable 'x' can be moved to tighter if scope after swapping with the following statement"
+	logln("start")
+	if x > 0 {
+		fmt.Println("positive")
+	}
+}
+
+func call() {
+	x := compute() // want "Variable 'x' can be moved to tighter if scope after swapping with the following statement"
+	logln("start")
+	if x > 0 {
+		fmt.Println("positive")
+	}
+}
+
+func dependent() {
+	n := 0
+	x := n + 1
+	n++
+	if x > 0 {
+		fmt.Println(n)
+	}
+}
+
+func twoStatements() {
+	x := 1
+	logln("start")
+	logln("again")
+	if x > 0 {
+		fmt.Println("positive")
+	}
+}
+
+func body(ok bool) {
+	x := 1
+	logln("start")
+	if ok {
+		fmt.Println(x)
+	}
+}
+
+var limit = 3
+
+func shadowing() {
+	x := limit
+	limit := compute()
+	if x > limit {
+		fmt.Println("greater")
+	}
+	fmt.Println(limit)
+}
+
+var counter int
+
+func bump() { counter++ }
+
+// The blocking statement can modify package-level variables read by the declaration.
+func packageVar() {
+	x := counter
+	bump()
+	if x > 0 {
+		fmt.Println("positive")
+	}
+}
+
+func bumpPtr(p *int) { *p++ }
+
+// The blocking statement can modify memory read through an aliased pointer.
+func pointer(p, q *int) {
+	x := *p
+	bumpPtr(q)
+	if x > 0 {
+		fmt.Println("positive")
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package testbehavior
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestMoves(t *testing.T) {
+	x := 1
+	if testing.Short() {
+		fmt.Println(x)
+	}
+}
+
+func TestShadow(t *testing.T) {
+	err := fmt.Errorf("outer")
+	if testing.Short() {
+		err := fmt.Errorf("inner")
+		fmt.Println(err)
+	}
+	fmt.Println(err) // want "Identifier 'err' used after previously shadowed"
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tsunused
+
+import "fmt"
+
+func describe(x any) {
+	switch v := x.(type) {
+	case int:
+		fmt.Println(v + 1)
+	case string: // want "Type switch variable 'v' is unused in this case"
+		fmt.Println("string")
+	case nil: // want "Type switch variable 'v' is unused in this case"
+	default:
+		fmt.Println(v)
+	}
+}
+
+func nested(x any) {
+	switch v := x.(type) {
+	case []int:
+		for _, e := range v {
+			fmt.Println(e)
+		}
+	case error, fmt.Stringer:
+		func() { fmt.Println(v) }()
+	}
+}
+
+func plain(x any) {
+	switch x.(type) {
+	case int:
+		fmt.Println("int")
+	}
+}
+
+func silenced(x any) {
+	switch v := x.(type) {
+	case int:
+		fmt.Println(v)
+	case string: //nolint:scopeguard
+	}
+}
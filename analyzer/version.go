@@ -0,0 +1,70 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"runtime/debug"
+
+	"fillmore-labs.com/scopeguard/internal/config"
+)
+
+// modulePath is the path of the scopeguard module.
+const modulePath = "fillmore-labs.com/scopeguard"
+
+// setFixComment sets the comment preceding declarations moved to blocks, if enabled.
+func (r *runOptions) setFixComment() {
+	r.fixComment = ""
+	if r.behavior.Enabled(config.FixComment) {
+		r.fixComment = fixComment(moduleVersion())
+	}
+}
+
+// fixComment returns the comment preceding declarations moved to blocks, mentioning the version if known.
+func fixComment(version string) string {
+	if version == "" {
+		return "// " + name + ": tightened scope"
+	}
+
+	return "// " + name + " " + version + ": tightened scope"
+}
+
+// moduleVersion returns the version of the scopeguard module from the build information,
+// or the empty string for development builds.
+func moduleVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+
+	version := ""
+	if info.Main.Path == modulePath {
+		version = info.Main.Version
+	} else {
+		for _, dep := range info.Deps {
+			if dep.Path == modulePath {
+				version = dep.Version
+				break
+			}
+		}
+	}
+
+	if version == "(devel)" {
+		return ""
+	}
+
+	return version
+}
@@ -21,5 +21,8 @@ and suggests moving them to tighter scopes.
 Usage:
 
 	scopeguard [flags] [package ...]
+
+The exit status is 0 without findings, 1 when the analysis failed and 3 when findings were reported,
+so the command can be used as a CI gate. With -fix or -json, findings don't affect the exit status.
 */
 package main
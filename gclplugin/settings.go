@@ -26,14 +26,109 @@ type Settings struct {
 	Shadow *bool `json:"shadow,omitzero"`
 	// NestedAssign enables nested assignment checks.
 	NestedAssign *bool `json:"nested-assign,omitzero"`
+	// DeadInit enables dead initial value checks.
+	DeadInit *bool `json:"dead-init,omitzero"`
+	// LoopShadow enables checks for loop body declarations shadowing for loop variables.
+	LoopShadow *bool `json:"loop-shadow,omitzero"`
+	// RangeShadow enables checks for range loop body declarations shadowing iteration variables.
+	RangeShadow *bool `json:"range-shadow,omitzero"`
+	// BranchInit enables checks for zero values overwritten on all branches of an if statement.
+	BranchInit *bool `json:"branch-init,omitzero"`
+	// LoopLast enables checks for variables only holding the final value assigned in a loop.
+	LoopLast *bool `json:"loop-last,omitzero"`
+	// GoCapture enables checks for variables only read inside a single goroutine function literal.
+	GoCapture *bool `json:"go-capture,omitzero"`
+
+	// NoopShadow enables checks for short variable declarations redeclaring variables as themselves.
+	NoopShadow *bool `json:"noop-shadow,omitzero"`
+
+	// Join enables checks for adjacent short variable declarations that can be joined into a single one.
+	Join *bool `json:"join,omitzero"`
+
+	// InlineRange enables checks for short variable declarations only used as the range expression of the following loop.
+	InlineRange *bool `json:"inline-range,omitzero"`
+
+	// LoopConst enables checks for never reassigned variables with constant initializers used in loop conditions.
+	LoopConst *bool `json:"loop-const,omitzero"`
+
+	// TSUnused enables checks for type switch case clauses not reading the variable bound by the switch.
+	TSUnused *bool `json:"ts-unused,omitzero"`
+
+	// CondInline enables checks for if statement initializers only used once in the condition.
+	CondInline *bool `json:"cond-inline,omitzero"`
+	// Swap enables checks for moves blocked by a single statement that can be swapped with the declaration.
+	Swap *bool `json:"swap,omitzero"`
+
 	// Conservative restricts moves to those without potential side effects.
 	Conservative *bool `json:"conservative,omitzero"`
 	// Combine enables combining declarations when moving to control flow initializers.
 	Combine *bool `json:"combine,omitzero"`
+	// GroupRelated reports combined declarations in a single diagnostic.
+	GroupRelated *bool `json:"group-related,omitzero"`
+	// LoopBody permits moving loop invariant declarations into loop bodies.
+	LoopBody *bool `json:"loop-body,omitzero"`
+	// PerfHints mentions initializers only evaluated when needed after moving.
+	PerfHints *bool `json:"perf-hints,omitzero"`
+	// AddressHints mentions moved variables whose address is taken.
+	AddressHints *bool `json:"address-hints,omitzero"`
+	// Closures tracks declarations inside function literals.
+	Closures *bool `json:"closures,omitzero"`
+	// OnlyErrors restricts moves to declarations of error variables.
+	OnlyErrors *bool `json:"only-errors,omitzero"`
+	// KeepDocumented reports moves of var declarations with doc comments without fixes.
+	KeepDocumented *bool `json:"keep-documented,omitzero"`
+	// SkipDocumented excludes var declarations with doc comments from moves.
+	SkipDocumented *bool `json:"skip-documented,omitzero"`
+	// IntroduceBlocks wraps declarations and the statements using them in new blocks.
+	IntroduceBlocks *bool `json:"introduce-blocks,omitzero"`
+	// IgnoreDebugPrints disregards debug print arguments when computing scopes.
+	IgnoreDebugPrints *bool `json:"ignore-debug-prints,omitzero"`
+	// PreferBlock moves short declarations to blocks instead of control flow initializers.
+	PreferBlock *bool `json:"prefer-block,omitzero"`
+	// Parallel analyzes the files of a package concurrently.
+	Parallel *bool `json:"parallel,omitzero"`
 	// Rename enables renaming of shadowed variables.
 	Rename *bool `json:"rename,omitzero"`
+	// RenameGenerated enables renaming of shadowed variables in analyzed generated files.
+	RenameGenerated *bool `json:"rename-generated,omitzero"`
+	// ReportBlocked reports blocked moves with their reason, even in conservative mode.
+	ReportBlocked *bool `json:"report-blocked,omitzero"`
+	// ReportAtTarget reports movable declarations at the target scope.
+	ReportAtTarget *bool `json:"report-at-target,omitzero"`
+	// TargetSnippets quotes the target scope in related information.
+	TargetSnippets *bool `json:"target-snippets,omitzero"`
+	// ReportOnly suppresses suggested fixes.
+	ReportOnly *bool `json:"report-only,omitzero"`
+	// ExplainUnused explains why variables are unused in related information.
+	ExplainUnused *bool `json:"explain-unused,omitzero"`
+	// FixComment precedes declarations moved to blocks with a comment naming the scopeguard version.
+	FixComment *bool `json:"fix-comment,omitzero"`
+	// IndentFix indents declarations moved to blocks like the statements of the target block.
+	IndentFix *bool `json:"indent-fix,omitzero"`
+	// Simplify rewrites moved var declarations with redundant types as short variable declarations.
+	Simplify *bool `json:"simplify,omitzero"`
 	// MaxLines sets the maximum declaration size for moving to control flow initializers.
 	MaxLines *int `json:"max-lines,omitzero"`
+	// MaxAbsorb sets the maximum number of declarations combined into another one.
+	MaxAbsorb *int `json:"max-absorb,omitzero"`
+	// MaxWidth sets the maximum width of control flow initializer lines with combined declarations.
+	MaxWidth *int `json:"max-width,omitzero"`
+	// MaxFuncSize sets the maximum number of statements of analyzed functions.
+	MaxFuncSize *int `json:"max-func-size,omitzero"`
+	// MinSpan sets the minimum number of lines from a declaration to the end of its usage scope.
+	MinSpan *int `json:"min-span,omitzero"`
+	// RenameLimit sets the maximum number of suffixes tried when renaming shadowed variables.
+	RenameLimit *int `json:"rename-limit,omitzero"`
+	// Baseline sets the path of a file of accepted findings that are not reported.
+	Baseline *string `json:"baseline,omitzero"`
+	// Category sets the category of reported diagnostics.
+	Category *string `json:"category,omitzero"`
+	// Quote sets the quote style of variable names in messages.
+	Quote *scopeguard.QuoteStyle `json:"quote,omitzero"`
+	// KeepPragma sets comments keeping declarations on their line at their scope, like "keep:top".
+	KeepPragma *[]string `json:"keep-pragma,omitzero"`
+	// TargetKinds sets the comma-separated kinds of target scopes declarations can be moved to.
+	TargetKinds *scopeguard.ScopeKind `json:"target-kinds,omitzero"`
 }
 
 // Options converts [Settings] into a list of [scopeguard.Option] for the scopeguard analyzer.
@@ -44,10 +139,58 @@ func (s Settings) Options() []scopeguard.Option {
 	opts = appendOption(opts, s.Scope, scopeguard.WithScope)
 	opts = appendOption(opts, s.Shadow, scopeguard.WithShadow)
 	opts = appendOption(opts, s.NestedAssign, scopeguard.WithNestedAssign)
+	opts = appendOption(opts, s.DeadInit, scopeguard.WithDeadInit)
+	opts = appendOption(opts, s.LoopShadow, scopeguard.WithLoopShadow)
+	opts = appendOption(opts, s.RangeShadow, scopeguard.WithRangeShadow)
+	opts = appendOption(opts, s.BranchInit, scopeguard.WithBranchInit)
+	opts = appendOption(opts, s.LoopLast, scopeguard.WithLoopLast)
+	opts = appendOption(opts, s.GoCapture, scopeguard.WithGoCapture)
+	opts = appendOption(opts, s.NoopShadow, scopeguard.WithNoopShadow)
+	opts = appendOption(opts, s.Join, scopeguard.WithJoin)
+	opts = appendOption(opts, s.InlineRange, scopeguard.WithInlineRange)
+	opts = appendOption(opts, s.LoopConst, scopeguard.WithLoopConst)
+	opts = appendOption(opts, s.TSUnused, scopeguard.WithTypeSwitchUnused)
+	opts = appendOption(opts, s.CondInline, scopeguard.WithCondInline)
+	opts = appendOption(opts, s.Swap, scopeguard.WithSwap)
 	opts = appendOption(opts, s.Conservative, scopeguard.WithConservative)
 	opts = appendOption(opts, s.Combine, scopeguard.WithCombine)
+	opts = appendOption(opts, s.GroupRelated, scopeguard.WithGroupRelated)
+	opts = appendOption(opts, s.LoopBody, scopeguard.WithLoopBodyMoves)
+	opts = appendOption(opts, s.PerfHints, scopeguard.WithPerfHints)
+	opts = appendOption(opts, s.AddressHints, scopeguard.WithAddressHints)
+	opts = appendOption(opts, s.Closures, scopeguard.WithAnalyzeClosures)
+	opts = appendOption(opts, s.OnlyErrors, scopeguard.WithOnlyErrorVars)
+	opts = appendOption(opts, s.KeepDocumented, scopeguard.WithKeepDocumentedDeclarations)
+	opts = appendOption(opts, s.SkipDocumented, scopeguard.WithSkipDocumentedDeclarations)
+	opts = appendOption(opts, s.TargetSnippets, scopeguard.WithTargetSnippets)
+	opts = appendOption(opts, s.IntroduceBlocks, scopeguard.WithIntroduceBlocks)
+	opts = appendOption(opts, s.IgnoreDebugPrints, scopeguard.WithIgnoreDebugPrints)
+	opts = appendOption(opts, s.PreferBlock, scopeguard.WithPreferBlock)
+	opts = appendOption(opts, s.Parallel, scopeguard.WithParallel)
 	opts = appendOption(opts, s.Rename, scopeguard.WithRename)
+	opts = appendOption(opts, s.RenameGenerated, scopeguard.WithRenameGenerated)
+	opts = appendOption(opts, s.ReportBlocked, scopeguard.WithReportBlocked)
+	opts = appendOption(opts, s.ReportAtTarget, scopeguard.WithReportAtTarget)
+	opts = appendOption(opts, s.ReportOnly, scopeguard.WithReportOnly)
+	opts = appendOption(opts, s.Simplify, scopeguard.WithSimplify)
+	opts = appendOption(opts, s.FixComment, scopeguard.WithFixComment)
+	opts = appendOption(opts, s.IndentFix, scopeguard.WithIndentFix)
+	opts = appendOption(opts, s.ExplainUnused, scopeguard.WithExplainUnused)
 	opts = appendOption(opts, s.MaxLines, scopeguard.WithMaxLines)
+	opts = appendOption(opts, s.MaxAbsorb, scopeguard.WithMaxAbsorb)
+	opts = appendOption(opts, s.MaxWidth, scopeguard.WithMaxWidth)
+	opts = appendOption(opts, s.MaxFuncSize, scopeguard.WithMaxFuncSize)
+	opts = appendOption(opts, s.MinSpan, scopeguard.WithMinSpan)
+	opts = appendOption(opts, s.RenameLimit, scopeguard.WithRenameLimit)
+	opts = appendOption(opts, s.Baseline, scopeguard.WithBaseline)
+	opts = appendOption(opts, s.Category, scopeguard.WithReportCategory)
+	opts = appendOption(opts, s.Quote, scopeguard.WithQuoteStyle)
+	opts = appendOption(opts, s.KeepPragma, func(pragmas []string) scopeguard.Option {
+		return scopeguard.WithKeepPragma(pragmas...)
+	})
+	opts = appendOption(opts, s.TargetKinds, func(kinds scopeguard.ScopeKind) scopeguard.Option {
+		return scopeguard.WithTargetKinds(kinds)
+	})
 
 	return opts
 }
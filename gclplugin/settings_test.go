@@ -30,10 +30,54 @@ const allSettings = `{
 	"scope": true,
 	"shadow": true,
 	"nested-assign": true,
+	"dead-init": false,
+	"loop-shadow": false,
+	"range-shadow": false,
+	"branch-init": false,
+	"loop-last": false,
+	"go-capture": false,
+	"noop-shadow": false,
+	"join": false,
+	"inline-range": false,
+	"loop-const": false,
+	"ts-unused": false,
+	"cond-inline": false,
+	"swap": false,
 	"conservative": false,
 	"combine": true,
+	"group-related": false,
+	"loop-body": false,
+	"perf-hints": false,
+	"address-hints": false,
+	"closures": true,
+	"only-errors": false,
+	"keep-documented": false,
+	"skip-documented": false,
+	"introduce-blocks": false,
+	"ignore-debug-prints": false,
+	"prefer-block": false,
+	"parallel": false,
 	"rename": true,
-	"max-lines": 10
+	"rename-generated": false,
+	"report-blocked": false,
+	"report-at-target": false,
+	"target-snippets": false,
+	"report-only": false,
+	"simplify": false,
+	"fix-comment": false,
+	"indent-fix": false,
+	"explain-unused": false,
+	"max-lines": 10,
+	"max-absorb": -1,
+	"max-width": -1,
+	"max-func-size": -1,
+	"min-span": 20,
+	"rename-limit": 99,
+	"baseline": "",
+	"category": "scopeguard",
+	"quote": "single",
+	"keep-pragma": ["keep:top"],
+	"target-kinds": "if-init,for-init,switch-init,type-switch-init,block,case,comm-clause"
 }`
 
 func TestSettings(t *testing.T) {
@@ -19,6 +19,7 @@ package astutil
 import (
 	"go/ast"
 	"go/token"
+	"go/types"
 	"regexp"
 	"slices"
 	"strings"
@@ -61,35 +62,90 @@ func (c CurrentFile) Generated() bool {
 	return c.generated
 }
 
+// GoVersion returns the Go version of the file recorded in info, or the empty string if unknown.
+func (c CurrentFile) GoVersion(info *types.Info) string {
+	return info.FileVersions[c.file]
+}
+
 // Lines returns the number of Lines a statement spans.
 func (c CurrentFile) Lines(stmt ast.Node) int {
 	return c.line(stmt.End()) - c.line(stmt.Pos()) + 1
 }
 
+// Span returns the number of lines between two positions, inclusive.
+func (c CurrentFile) Span(from, to token.Pos) int {
+	return c.line(to) - c.line(from) + 1
+}
+
 func (c CurrentFile) line(pos token.Pos) int {
 	return c.handle.PositionFor(pos, false).Line
 }
 
 // NoLintComment checks if a line is followed by a //nolint:scopeguard comment.
 func (c CurrentFile) NoLintComment(pos token.Pos) bool {
-	if c.file == nil {
+	comment, ok := c.lineComment(pos)
+
+	return ok && CommentHasNoLint(comment)
+}
+
+// PragmaComment checks if a line is followed by a comment consisting of one of the pragmas,
+// optionally followed by an explanation, like //keep:top for the pragma "keep:top".
+func (c CurrentFile) PragmaComment(pos token.Pos, pragmas []string) bool {
+	if len(pragmas) == 0 {
 		return false
 	}
 
+	comment, ok := c.lineComment(pos)
+	if !ok {
+		return false
+	}
+
+	text, ok := strings.CutPrefix(comment.Text, "//")
+	if !ok {
+		return false
+	}
+
+	text = strings.TrimSpace(text)
+
+	return slices.ContainsFunc(pragmas, func(pragma string) bool {
+		rest, ok := strings.CutPrefix(text, pragma)
+
+		return ok && (rest == "" || rest[0] == ' ' || rest[0] == '\t')
+	})
+}
+
+// lineComment returns the first comment starting after pos on the same line.
+func (c CurrentFile) lineComment(pos token.Pos) (*ast.Comment, bool) {
+	if c.file == nil {
+		return nil, false
+	}
+
 	// find the first comment starting after the declaration
 	i, _ := slices.BinarySearchFunc(c.file.Comments, pos,
 		func(c *ast.CommentGroup, p token.Pos) int { return int(c.Pos() - p) })
 	if i >= len(c.file.Comments) {
-		return false
+		return nil, false
 	}
 
 	comment := c.file.Comments[i].List[0]
 
 	if c.line(comment.Pos()) != c.line(pos) {
-		return false // not on this line
+		return nil, false // not on this line
 	}
 
-	return CommentHasNoLint(comment)
+	return comment, true
+}
+
+// HasComment reports whether a comment starts within the range [from, to).
+func (c CurrentFile) HasComment(from, to token.Pos) bool {
+	if c.file == nil {
+		return false
+	}
+
+	i, _ := slices.BinarySearchFunc(c.file.Comments, from,
+		func(c *ast.CommentGroup, p token.Pos) int { return int(c.Pos() - p) })
+
+	return i < len(c.file.Comments) && c.file.Comments[i].Pos() < to
 }
 
 var nolintPattern = regexp.MustCompile(`^//\s*nolint:([a-zA-Z0-9,_-]+)`)
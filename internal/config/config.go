@@ -17,7 +17,7 @@
 package config
 
 // AnalyzerFlags represents specific analyzers.
-type AnalyzerFlags uint8
+type AnalyzerFlags uint16
 
 const (
 	// ScopeAnalyzer enables scope-based analysis for identifying variable declarations and usage.
@@ -28,10 +28,61 @@ const (
 
 	// NestedAssignAnalyzer enables the analysis of nested assignments.
 	NestedAssignAnalyzer
+
+	// DeadInitAnalyzer enables the analysis of initial values overwritten before being read.
+	DeadInitAnalyzer
+
+	// LoopShadowAnalyzer enables the analysis of loop body declarations shadowing for loop variables.
+	LoopShadowAnalyzer
+
+	// BranchInitAnalyzer enables the analysis of zero values overwritten on all branches of an if statement.
+	BranchInitAnalyzer
+
+	// LoopLastAnalyzer enables the analysis of variables only holding the final value assigned in a loop.
+	LoopLastAnalyzer
+
+	// RangeShadowAnalyzer enables the analysis of range loop body declarations shadowing iteration variables.
+	RangeShadowAnalyzer
+
+	// GoCaptureAnalyzer enables the analysis of variables only read inside a single goroutine function literal.
+	GoCaptureAnalyzer
+
+	// JoinAnalyzer enables the analysis of adjacent short variable declarations that can be joined.
+	JoinAnalyzer
+
+	// NoopShadowAnalyzer enables the analysis of short variable declarations redeclaring variables as themselves.
+	NoopShadowAnalyzer
+
+	// InlineRangeAnalyzer enables the analysis of variables only used as the range expression of the following loop.
+	InlineRangeAnalyzer
+
+	// LoopConstAnalyzer enables the analysis of never reassigned variables with constant initializers
+	// used in for loop conditions.
+	LoopConstAnalyzer
+
+	// TypeSwitchUnusedAnalyzer enables the analysis of type switch case clauses not reading the bound variable.
+	TypeSwitchUnusedAnalyzer
+
+	// CondInlineAnalyzer enables the analysis of if statement initializers only used once in the condition.
+	CondInlineAnalyzer
+
+	// SwapAnalyzer enables the analysis of moves blocked in conservative mode by a single statement
+	// that can be swapped with the declaration.
+	SwapAnalyzer
 )
 
+// StrictAnalyzers is the set of analyzers enabled by a strict analyzer:
+// [ScopeAnalyzer] | [ShadowAnalyzer] | [NestedAssignAnalyzer] | [DeadInitAnalyzer] | [LoopShadowAnalyzer] |
+// [BranchInitAnalyzer] | [LoopLastAnalyzer] | [RangeShadowAnalyzer] | [GoCaptureAnalyzer] | [JoinAnalyzer] |
+// [NoopShadowAnalyzer] | [InlineRangeAnalyzer] | [LoopConstAnalyzer] | [TypeSwitchUnusedAnalyzer] |
+// [CondInlineAnalyzer] | [SwapAnalyzer].
+const StrictAnalyzers = ScopeAnalyzer | ShadowAnalyzer | NestedAssignAnalyzer | DeadInitAnalyzer | LoopShadowAnalyzer |
+	BranchInitAnalyzer | LoopLastAnalyzer | RangeShadowAnalyzer | GoCaptureAnalyzer | JoinAnalyzer |
+	NoopShadowAnalyzer | InlineRangeAnalyzer | LoopConstAnalyzer | TypeSwitchUnusedAnalyzer | CondInlineAnalyzer |
+	SwapAnalyzer
+
 // Config represents configuration options for the analyzers.
-type Config uint8
+type Config uint32
 
 const (
 	// IncludeGenerated specifies whether to include analysis of generated files.
@@ -45,4 +96,76 @@ const (
 
 	// RenameVariables indicates that shadowed variables should be renamed.
 	RenameVariables
+
+	// ReportOnly suppresses all suggested fixes, only reporting diagnostics.
+	ReportOnly
+
+	// GroupRelated reports combined declarations in a single diagnostic.
+	GroupRelated
+
+	// LoopBodyMoves permits moving loop invariant declarations into loop bodies.
+	LoopBodyMoves
+
+	// Color highlights diagnostic message components with ANSI escape sequences.
+	Color
+
+	// ReportAtTarget reports moves at the target scope instead of the declaration.
+	ReportAtTarget
+
+	// SimplifyDeclarations rewrites var declarations moved to a block as short variable declarations
+	// when the explicit type is the inferred type anyway.
+	SimplifyDeclarations
+
+	// ParallelFiles analyzes the files of a package concurrently.
+	ParallelFiles
+
+	// PreferBlock moves short variable declarations to blocks instead of control flow initializers.
+	PreferBlock
+
+	// PerfHints mentions in move diagnostics when a call in the initializer would only be evaluated when needed.
+	PerfHints
+
+	// IgnoreDebugPrints disregards uses as arguments of debug prints when computing the usage scope.
+	IgnoreDebugPrints
+
+	// TargetSnippets quotes the first line of the target scope in the related information of moves.
+	TargetSnippets
+
+	// AnalyzeClosures tracks declarations inside function literals.
+	AnalyzeClosures
+
+	// OnlyErrorVars restricts moves to declarations of variables named err or implementing error.
+	OnlyErrorVars
+
+	// KeepDocumented reports moves of var declarations with doc comments without suggested fixes.
+	KeepDocumented
+
+	// SkipDocumented excludes var declarations with doc comments from moves.
+	SkipDocumented
+
+	// FixComment precedes declarations moved to blocks with a comment naming the tool and its version.
+	FixComment
+
+	// ExplainUnused adds related information explaining why variables are unused.
+	ExplainUnused
+
+	// RenameGenerated permits rename fixes in generated files, when they are analyzed.
+	RenameGenerated
+
+	// IndentFix indents declarations moved to blocks like the statements of the target block.
+	IndentFix
+
+	// AddressHints mentions in move diagnostics when the address of a moved variable is taken.
+	AddressHints
+
+	// IntroduceBlocks suggests fixes wrapping declarations and the statements using them in a new block.
+	IntroduceBlocks
+
+	// ReportBlocked reports blocked moves in conservative mode, too, and adds the blocking reason
+	// as related information.
+	ReportBlocked
 )
+
+// StrictBehavior is the set of behavior options enabled by a strict analyzer:
+// [CombineDeclarations] | [RenameVariables].
+const StrictBehavior = CombineDeclarations | RenameVariables
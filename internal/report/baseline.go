@@ -0,0 +1,122 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"go/token"
+	"io"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidBaseline is returned when a baseline contains a malformed entry.
+var ErrInvalidBaseline = errors.New("invalid baseline entry")
+
+// BaselineTolerance is the number of lines a finding may have moved from its baseline entry and still be suppressed.
+const BaselineTolerance = 3
+
+// Baseline is a set of accepted findings that are not reported.
+//
+// Each line of a baseline holds a file:line:name entry, naming one of the variables of a finding.
+// Empty lines and lines starting with '#' are ignored. Entries match findings for the same
+// variable name in a file with the given path suffix within [BaselineTolerance] lines,
+// so minor edits don't invalidate the baseline.
+type Baseline struct {
+	entries map[string][]baselineEntry
+}
+
+// baselineEntry is an accepted finding for a variable name.
+type baselineEntry struct {
+	file string
+	line int
+}
+
+// ParseBaseline reads a baseline from r.
+func ParseBaseline(r io.Reader) (*Baseline, error) {
+	b := &Baseline{entries: make(map[string][]baselineEntry)}
+
+	scanner := bufio.NewScanner(r)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		rest, name, ok := cutLast(text)
+		if !ok || !token.IsIdentifier(name) {
+			return nil, fmt.Errorf("line %d: %w %q", lineNo, ErrInvalidBaseline, text)
+		}
+
+		file, lineText, ok := cutLast(rest)
+		if !ok || file == "" {
+			return nil, fmt.Errorf("line %d: %w %q", lineNo, ErrInvalidBaseline, text)
+		}
+
+		line, err := strconv.Atoi(lineText)
+		if err != nil || line < 1 {
+			return nil, fmt.Errorf("line %d: %w %q", lineNo, ErrInvalidBaseline, text)
+		}
+
+		b.entries[name] = append(b.entries[name], baselineEntry{file: filepath.ToSlash(file), line: line})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// cutLast slices s around the last colon.
+func cutLast(s string) (before, after string, found bool) {
+	i := strings.LastIndexByte(s, ':')
+	if i < 0 {
+		return s, "", false
+	}
+
+	return s[:i], s[i+1:], true
+}
+
+// Accepted reports whether the baseline contains one of the variable names of a finding at the position.
+func (b *Baseline) Accepted(position token.Position, names ...string) bool {
+	if b == nil {
+		return false
+	}
+
+	filename := filepath.ToSlash(position.Filename)
+
+	for _, name := range names {
+		if slices.ContainsFunc(b.entries[name], func(e baselineEntry) bool {
+			return matchesFile(filename, e.file) &&
+				position.Line-BaselineTolerance <= e.line && e.line <= position.Line+BaselineTolerance
+		}) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesFile reports whether the slash-separated filename is the path or ends with the path suffix.
+func matchesFile(filename, path string) bool {
+	return filename == path || strings.HasSuffix(filename, "/"+path)
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	"errors"
+	"go/token"
+	"strings"
+	"testing"
+
+	. "fillmore-labs.com/scopeguard/internal/report"
+)
+
+func TestParseBaseline(t *testing.T) {
+	t.Parallel()
+
+	const src = `
+# Accepted findings
+pkg/file.go:10:x
+/src/pkg/other.go:20:err
+`
+
+	b, err := ParseBaseline(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("Can't parse baseline: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		position token.Position
+		names    []string
+		expected bool
+	}{
+		{"exact", token.Position{Filename: "/root/pkg/file.go", Line: 10}, []string{"x"}, true},
+		{"shifted", token.Position{Filename: "/root/pkg/file.go", Line: 13}, []string{"x"}, true},
+		{"too far", token.Position{Filename: "/root/pkg/file.go", Line: 14}, []string{"x"}, false},
+		{"other name", token.Position{Filename: "/root/pkg/file.go", Line: 10}, []string{"y"}, false},
+		{"one of names", token.Position{Filename: "/root/pkg/file.go", Line: 10}, []string{"y", "x"}, true},
+		{"partial path", token.Position{Filename: "/root/xpkg/file.go", Line: 10}, []string{"x"}, false},
+		{"absolute", token.Position{Filename: "/src/pkg/other.go", Line: 20}, []string{"err"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := b.Accepted(tt.position, tt.names...); got != tt.expected {
+				t.Errorf("Accepted(%v, %v) = %v, want %v", tt.position, tt.names, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseBaselineInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, src := range []string{
+		"file.go:10",
+		"file.go:x:y",
+		"file.go:0:x",
+		":10:x",
+		"file.go:10:1x",
+	} {
+		if _, err := ParseBaseline(strings.NewReader(src)); !errors.Is(err, ErrInvalidBaseline) {
+			t.Errorf("ParseBaseline(%q) = %v, want %v", src, err, ErrInvalidBaseline)
+		}
+	}
+}
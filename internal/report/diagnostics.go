@@ -17,21 +17,26 @@
 package report
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"go/ast"
 	"go/token"
+	"go/types"
 	"runtime/trace"
 	"slices"
 	"strings"
+	"unicode/utf8"
 
 	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/edge"
 	"golang.org/x/tools/go/ast/inspector"
 
 	"fillmore-labs.com/scopeguard/internal/astutil"
 	"fillmore-labs.com/scopeguard/internal/config"
 	"fillmore-labs.com/scopeguard/internal/scope"
 	"fillmore-labs.com/scopeguard/internal/target"
+	"fillmore-labs.com/scopeguard/internal/target/check"
 	"fillmore-labs.com/scopeguard/internal/usage"
 )
 
@@ -40,28 +45,96 @@ import (
 // This is the final phase of the analyzer pipeline. For each move target identified by the
 // target phase, this function constructs a diagnostic message describing what can be moved
 // and where, generates a suggested fix with text edits to perform the move (if possible) and
-// reports the diagnostic to the analysis framework.
-func ProcessDiagnostics(ctx context.Context, p *analysis.Pass, currentFile astutil.CurrentFile, fdecl inspector.Cursor, diagnostics Diagnostics, option config.BitMask[config.Config]) {
+// reports the diagnostic to the analysis framework. Findings dropped by the filter are not reported.
+// Variable names in messages are quoted in style q. Declarations moved to blocks are preceded by fixComment, if set.
+func ProcessDiagnostics(ctx context.Context, p *analysis.Pass, currentFile astutil.CurrentFile, fdecl inspector.Cursor, diagnostics Diagnostics, option config.BitMask[config.Config], renameConfig RenameConfig, filter Filter, q QuoteStyle, fixComment string) {
 	defer trace.StartRegion(ctx, "Report").End()
 
 	in := fdecl.Inspector()
 
+	// Drop findings accepted by the baseline or outside the changed lines
+	diagnostics = filter.apply(p.Fset, in, diagnostics)
+
+	reportOnly := option.Enabled(config.ReportOnly)
+
 	// Report nested assignments
-	reportNestedAssigned(ctx, p, in, currentFile, diagnostics.Nested)
+	reportNestedAssigned(ctx, p, in, currentFile, diagnostics.Nested, q)
 
-	// Report variables used after shadowed
-	rename := option.Enabled(config.RenameVariables) && !currentFile.Generated()
-	hadFixes := reportUsedAfterShadow(ctx, p, currentFile, fdecl, diagnostics.Shadows, rename)
+	// Report loop body declarations shadowing loop variables
+	reportLoopShadows(ctx, p, currentFile, diagnostics.LoopShadows, q)
 
-	if len(diagnostics.Moves) == 0 {
-		return
+	// Report zero values overwritten on all branches
+	reportBranchInits(ctx, p, in, currentFile, diagnostics.BranchInits, q)
+
+	// Report variables only holding a loop's final value
+	reportLoopLasts(ctx, p, in, currentFile, diagnostics.LoopLasts, q)
+
+	// Report variables only read inside a goroutine
+	reportGoCaptures(ctx, p, in, currentFile, diagnostics.GoCaptures, q)
+
+	// Report variables only used as a range expression
+	reportInlineRanges(ctx, p, in, currentFile, diagnostics.InlineRanges, q)
+	reportTSUnused(ctx, p, in, currentFile, diagnostics.TSUnused, q)
+	reportCondInlines(ctx, p, in, currentFile, diagnostics.CondInlines, q)
+
+	// Report initial values overwritten before being read
+	edits := reportDeadInits(ctx, p, in, currentFile, diagnostics.DeadInits, !reportOnly && !currentFile.Generated(), q)
+
+	// Report redeclarations of variables as themselves
+	edits = append(edits, reportNoopShadows(ctx, p, in, currentFile, diagnostics.NoopShadows, !reportOnly && !currentFile.Generated(), q)...)
+
+	// Report variables bounding loops that can be constants
+	edits = append(edits, reportLoopConsts(ctx, p, in, currentFile, diagnostics.LoopConsts, !reportOnly && !currentFile.Generated(), q)...)
+
+	// Report movable declarations
+	edits = append(edits, reportMoves(ctx, p, in, diagnostics.Moves, !reportOnly, option, q, fixComment)...)
+
+	// Report adjacent declarations that can be joined, unless conflicting with the edits above
+	edits = append(edits, reportJoins(ctx, p, in, currentFile, diagnostics.Joins, !reportOnly && !currentFile.Generated(), edits, q)...)
+
+	// Report moves unblocked by swapping two statements, unless conflicting with the edits above
+	edits = append(edits, reportSwaps(ctx, p, in, currentFile, diagnostics.Swaps, !reportOnly && !currentFile.Generated(), edits, q)...)
+
+	// Report declarations that can be scoped by a new block, unless conflicting with the edits above
+	edits = append(edits, reportIntroBlocks(ctx, p, in, currentFile, diagnostics.IntroBlocks, !reportOnly && !currentFile.Generated(), option.Enabled(config.IndentFix), edits, q)...)
+
+	// Report variables used after shadowed, renaming them unless conflicting with the edits above
+	renameFile := !currentFile.Generated() || option.Enabled(config.RenameGenerated)
+	rename := option.Enabled(config.RenameVariables) && renameFile && !reportOnly
+	var renamer *Renamer
+	if rename {
+		renamer = NewRenamer(p.Fset, renameConfig)
 	}
 
-	conservative := option.Enabled(config.Conservative)
+	reportUsedAfterShadow(ctx, p, currentFile, fdecl, diagnostics.Shadows, renamer, edits, q)
+}
 
-	for _, move := range diagnostics.Moves {
+// reportMoves emits diagnostics for declarations that can be moved to tighter scopes.
+//
+// If fixes is false, suggested fixes are suppressed, as when only reporting is requested.
+// Declarations moved to blocks are preceded by fixComment, if set.
+// Returns the text edits of all suggested fixes.
+//
+// With [config.GroupRelated], the names of absorbed declarations are included in the message of the move they are
+// merged into. With [config.Color], message components are highlighted for terminal output.
+// With [config.ReportAtTarget], diagnostics are reported at the target scope, with the declaration
+// as related information. With [config.ReportBlocked], blocked moves are reported in conservative mode, too,
+// with the blocking reason as related information.
+func reportMoves(ctx context.Context, p *analysis.Pass, in *inspector.Inspector, moves []target.MoveTarget, fixes bool, option config.BitMask[config.Config], q QuoteStyle, fixComment string) []analysis.TextEdit {
+	defer trace.StartRegion(ctx, "ReportMoves").End()
+
+	conservative, group := option.Enabled(config.Conservative), option.Enabled(config.GroupRelated)
+	atTarget, simplify := option.Enabled(config.ReportAtTarget), option.Enabled(config.SimplifyDeclarations)
+	st := style(option.Enabled(config.Color))
+	perfHints, snippets := option.Enabled(config.PerfHints), option.Enabled(config.TargetSnippets)
+	explain, indent := option.Enabled(config.ExplainUnused), option.Enabled(config.IndentFix)
+	blocked := option.Enabled(config.ReportBlocked)
+
+	var allEdits []analysis.TextEdit
+
+	for _, move := range moves {
 		movable := move.Status.Movable()
-		if conservative && !movable {
+		if conservative && !movable && !blocked {
 			continue
 		}
 
@@ -73,22 +146,60 @@ func ProcessDiagnostics(ctx context.Context, p *analysis.Pass, currentFile astut
 			End: node.End(),
 		}
 
-		diagnostic.Message, diagnostic.Related = createMessage(in, move)
+		message, related := createMessage(p, in, move, group, snippets)
+		message.quote = q
+		if explain {
+			related = append(related, unusedCauses(in, move.Causes, q)...)
+		}
+		if reason := move.Status.Reason(); blocked && reason != "" {
+			related = append(related, analysis.RelatedInformation{Pos: node.Pos(), End: node.End(), Message: "Move blocked: " + reason})
+		}
+		if perfHints && move.TargetNode != nil {
+			message.lazy = lazyEvaluation(p.TypesInfo, in, node, move.TargetNode)
+		}
+		message.addressTaken = move.AddressTaken
+		if atTarget && move.TargetNode != nil {
+			// Swap the primary position with the target scope
+			diagnostic.Pos, diagnostic.End = move.TargetNode.Pos(), token.NoPos
+			related[0] = analysis.RelatedInformation{Pos: node.Pos(), End: node.End(), Message: "From this declaration"}
+		}
+
+		diagnostic.Message, diagnostic.Related = message.format(st), related
 
-		if movable && !hadFixes {
-			// If hadFixes is true, suggested fixes are suppressed. This is used to prevent conflicting
-			// text edits when other fixes (like variable renaming) have already been applied in the same pass.
-			if edits := createEdits(p, in, move); len(edits) > 0 {
-				diagnostic.SuggestedFixes = []analysis.SuggestedFix{{Message: diagnostic.Message, TextEdits: edits}}
+		if movable && fixes {
+			if edits := createEdits(p, in, move, simplify, indent, fixComment); len(edits) > 0 {
+				diagnostic.SuggestedFixes = []analysis.SuggestedFix{{Message: message.String(), TextEdits: edits}}
+				allEdits = append(allEdits, edits...)
 			}
 		}
 
 		p.Report(diagnostic)
 	}
+
+	return allEdits
+}
+
+// SuggestedFix returns the suggested fix performing a move, if it is movable.
+//
+// With [config.SimplifyDeclarations], moved var declarations are simplified as in reported diagnostics.
+// With [config.IndentFix], declarations moved to blocks are indented as in reported diagnostics.
+func SuggestedFix(p *analysis.Pass, in *inspector.Inspector, move target.MoveTarget, option config.BitMask[config.Config]) (analysis.SuggestedFix, bool) {
+	if !move.Status.Movable() {
+		return analysis.SuggestedFix{}, false
+	}
+
+	edits := createEdits(p, in, move, option.Enabled(config.SimplifyDeclarations), option.Enabled(config.IndentFix), "")
+	if len(edits) == 0 {
+		return analysis.SuggestedFix{}, false
+	}
+
+	message, _ := createMessage(p, in, move, option.Enabled(config.GroupRelated), false)
+
+	return analysis.SuggestedFix{Message: message.String(), TextEdits: edits}, true
 }
 
 // reportNestedAssigned emits diagnostics for nested assigns of variables.
-func reportNestedAssigned(ctx context.Context, p *analysis.Pass, in *inspector.Inspector, currentFile astutil.CurrentFile, nested []usage.NestedAssign) {
+func reportNestedAssigned(ctx context.Context, p *analysis.Pass, in *inspector.Inspector, currentFile astutil.CurrentFile, nested []usage.NestedAssign, q QuoteStyle) {
 	defer trace.StartRegion(ctx, "ReportNestedAssigned").End()
 
 	for _, assignment := range nested {
@@ -101,7 +212,7 @@ func reportNestedAssigned(ctx context.Context, p *analysis.Pass, in *inspector.I
 		p.Report(analysis.Diagnostic{
 			Pos:     assignment.Ident.Pos(),
 			End:     assignment.Ident.End(),
-			Message: fmt.Sprintf("Nested reassignment of variable '%s' (sg:nst)", assignment.Ident.Name),
+			Message: fmt.Sprintf("Nested reassignment of variable %s (sg:nst)", q.quote(assignment.Ident.Name)),
 			Related: []analysis.RelatedInformation{{
 				Pos:     stmt.Pos(),
 				End:     stmt.End(),
@@ -111,16 +222,520 @@ func reportNestedAssigned(ctx context.Context, p *analysis.Pass, in *inspector.I
 	}
 }
 
-// reportUsedAfterShadow emits diagnostics for variables used after previously shadowed.
-func reportUsedAfterShadow(ctx context.Context, p *analysis.Pass, currentFile astutil.CurrentFile, fdecl inspector.Cursor, shadows []usage.ShadowUse, rename bool) bool {
-	defer trace.StartRegion(ctx, "ReportShadowed").End()
+// reportLoopShadows emits diagnostics for for and range loop body declarations shadowing loop variables.
+func reportLoopShadows(ctx context.Context, p *analysis.Pass, currentFile astutil.CurrentFile, loopShadows []usage.LoopShadow, q QuoteStyle) {
+	defer trace.StartRegion(ctx, "ReportLoopShadows").End()
 
-	var renamer *Renamer
-	if rename {
-		renamer = NewRenamer()
+	for _, shadow := range loopShadows {
+		pos := shadow.Var.Pos()
+		if currentFile.NoLintComment(pos) {
+			continue
+		}
+
+		format := "Declaration of %s shadows the loop variable (sg:loop-shadow)"
+		if shadow.Range {
+			format = "Declaration of %s shadows the range variable (sg:range-shadow)"
+		}
+
+		p.Report(analysis.Diagnostic{
+			Pos:     pos,
+			End:     pos + token.Pos(len(shadow.Var.Name())),
+			Message: fmt.Sprintf(format, q.quote(shadow.Var.Name())),
+			Related: []analysis.RelatedInformation{{
+				Pos:     shadow.Shadowed.Pos(),
+				End:     shadow.Shadowed.Pos() + token.Pos(len(shadow.Shadowed.Name())),
+				Message: "Loop variable declared here",
+			}},
+		})
+	}
+}
+
+// reportDeadInits emits diagnostics for declarations whose initial values are overwritten before being read.
+//
+// Returns the text edits of all suggested fixes.
+func reportDeadInits(ctx context.Context, p *analysis.Pass, in *inspector.Inspector, currentFile astutil.CurrentFile, deadInits []usage.DeadInit, fixes bool, q QuoteStyle) []analysis.TextEdit {
+	defer trace.StartRegion(ctx, "ReportDeadInits").End()
+
+	var allEdits []analysis.TextEdit
+
+	for _, deadInit := range deadInits {
+		decl := deadInit.Decl.Node(in)
+		if currentFile.NoLintComment(decl.Pos()) {
+			continue
+		}
+
+		format := "Initial value of variable %s is overwritten before being read (sg:dead-init)"
+		if len(deadInit.Vars) > 1 {
+			format = "Initial values of variables %s are overwritten before being read (sg:dead-init)"
+		}
+
+		names := make([]string, len(deadInit.Vars))
+		for i, v := range deadInit.Vars {
+			names[i] = v.Name()
+		}
+
+		asgn := deadInit.Asgn.Node(in)
+
+		diagnostic := analysis.Diagnostic{
+			Pos:     decl.Pos(),
+			End:     decl.End(),
+			Message: fmt.Sprintf(format, concatNames(names, q)),
+			Related: []analysis.RelatedInformation{{
+				Pos:     asgn.Pos(),
+				End:     asgn.End(),
+				Message: "Overwritten by this assignment",
+			}},
+		}
+
+		if fixes && deadInit.Fixable {
+			if edits := mergeDeadInit(p, decl, asgn); len(edits) > 0 {
+				diagnostic.SuggestedFixes = []analysis.SuggestedFix{{Message: diagnostic.Message, TextEdits: edits}}
+				allEdits = append(allEdits, edits...)
+			}
+		}
+
+		p.Report(diagnostic)
+	}
+
+	return allEdits
+}
+
+// reportNoopShadows emits diagnostics for short variable declarations redeclaring variables as themselves.
+//
+// Returns the text edits of all suggested fixes.
+func reportNoopShadows(ctx context.Context, p *analysis.Pass, in *inspector.Inspector, currentFile astutil.CurrentFile, noopShadows []usage.NoopShadow, fixes bool, q QuoteStyle) []analysis.TextEdit {
+	defer trace.StartRegion(ctx, "ReportNoopShadows").End()
+
+	var allEdits []analysis.TextEdit
+
+	for _, noopShadow := range noopShadows {
+		decl := noopShadow.Decl.Node(in)
+		if currentFile.NoLintComment(decl.Pos()) {
+			continue
+		}
+
+		format := "Variable %s is redeclared as itself and can be removed (sg:noop-shadow)"
+		if len(noopShadow.Vars) > 1 {
+			format = "Variables %s are redeclared as themselves and can be removed (sg:noop-shadow)"
+		}
+
+		diagnostic := analysis.Diagnostic{
+			Pos:     decl.Pos(),
+			End:     decl.End(),
+			Message: fmt.Sprintf(format, concatNames(varNames(noopShadow.Vars), q)),
+		}
+
+		if fixes {
+			pos, end := removalBounds(p, decl)
+			edits := []analysis.TextEdit{{Pos: pos, End: end}}
+			diagnostic.SuggestedFixes = []analysis.SuggestedFix{{Message: diagnostic.Message, TextEdits: edits}}
+			allEdits = append(allEdits, edits...)
+		}
+
+		p.Report(diagnostic)
+	}
+
+	return allEdits
+}
+
+// SkippedFunc emits an informational diagnostic for a function declaration not analyzed
+// because it has more than limit statements, unless it is dropped by the filter.
+func SkippedFunc(p *analysis.Pass, fdecl *ast.FuncDecl, limit int, filter Filter, q QuoteStyle) {
+	position := p.Fset.Position(fdecl.Pos())
+	if filter.Baseline.Accepted(position, fdecl.Name.Name) || !filter.Lines.Contains(position) {
+		return
+	}
+
+	p.Report(analysis.Diagnostic{
+		Pos:     fdecl.Name.Pos(),
+		End:     fdecl.Name.End(),
+		Message: fmt.Sprintf("Function %s is not analyzed, it has more than %d statements (sg:skip)", q.quote(fdecl.Name.Name), limit),
+	})
+}
+
+// reportLoopConsts emits diagnostics for never reassigned variables with constant initializers used in loop conditions.
+// The suggested fix rewrites the short variable declaration as a constant declaration.
+//
+// Returns the text edits of all suggested fixes.
+func reportLoopConsts(ctx context.Context, p *analysis.Pass, in *inspector.Inspector, currentFile astutil.CurrentFile, loopConsts []usage.LoopConst, fixes bool, q QuoteStyle) []analysis.TextEdit {
+	defer trace.StartRegion(ctx, "ReportLoopConsts").End()
+
+	var allEdits []analysis.TextEdit
+
+	for _, loopConst := range loopConsts {
+		decl, ok := loopConst.Decl.Node(in).(*ast.AssignStmt)
+		if !ok || currentFile.NoLintComment(decl.Pos()) {
+			continue
+		}
+
+		diagnostic := analysis.Diagnostic{
+			Pos:     decl.Pos(),
+			End:     decl.End(),
+			Message: fmt.Sprintf("Variable %s bounding a loop is never reassigned and can be a constant (sg:loop-const)", q.quote(loopConst.Var.Name())),
+		}
+
+		if fixes {
+			edits := []analysis.TextEdit{
+				{Pos: decl.Pos(), End: decl.Pos(), NewText: []byte("const ")},
+				{Pos: decl.TokPos, End: decl.TokPos + token.Pos(len(token.DEFINE.String())), NewText: []byte("=")},
+			}
+			diagnostic.SuggestedFixes = []analysis.SuggestedFix{{Message: diagnostic.Message, TextEdits: edits}}
+			allEdits = append(allEdits, edits...)
+		}
+
+		p.Report(diagnostic)
 	}
 
-	hadFixes := false
+	return allEdits
+}
+
+// reportBranchInits emits diagnostics for var declarations whose zero values are overwritten on all branches.
+func reportBranchInits(ctx context.Context, p *analysis.Pass, in *inspector.Inspector, currentFile astutil.CurrentFile, branchInits []usage.BranchInit, q QuoteStyle) {
+	defer trace.StartRegion(ctx, "ReportBranchInits").End()
+
+	for _, branchInit := range branchInits {
+		decl := branchInit.Decl.Node(in)
+		if currentFile.NoLintComment(decl.Pos()) {
+			continue
+		}
+
+		format := "Zero value of variable %s is overwritten on all branches (sg:branch-init)"
+		if len(branchInit.Vars) > 1 {
+			format = "Zero values of variables %s are overwritten on all branches (sg:branch-init)"
+		}
+
+		names := make([]string, len(branchInit.Vars))
+		for i, v := range branchInit.Vars {
+			names[i] = v.Name()
+		}
+
+		ifStmt := branchInit.If.Node(in)
+
+		p.Report(analysis.Diagnostic{
+			Pos:     decl.Pos(),
+			End:     decl.End(),
+			Message: fmt.Sprintf(format, concatNames(names, q)),
+			Related: []analysis.RelatedInformation{{
+				Pos:     ifStmt.Pos(),
+				End:     ifStmt.End(),
+				Message: "Assigned on all branches of this if statement",
+			}},
+		})
+	}
+}
+
+// reportLoopLasts emits diagnostics for var declarations whose variables only hold the final value assigned in a loop.
+func reportLoopLasts(ctx context.Context, p *analysis.Pass, in *inspector.Inspector, currentFile astutil.CurrentFile, loopLasts []usage.LoopLast, q QuoteStyle) {
+	defer trace.StartRegion(ctx, "ReportLoopLasts").End()
+
+	for _, loopLast := range loopLasts {
+		decl := loopLast.Decl.Node(in)
+		if currentFile.NoLintComment(decl.Pos()) {
+			continue
+		}
+
+		format := "Variable %s only holds the last value assigned in the loop (sg:loop-last)"
+		if len(loopLast.Vars) > 1 {
+			format = "Variables %s only hold the last values assigned in the loop (sg:loop-last)"
+		}
+
+		names := make([]string, len(loopLast.Vars))
+		for i, v := range loopLast.Vars {
+			names[i] = v.Name()
+		}
+
+		loop := loopLast.Loop.Node(in)
+
+		p.Report(analysis.Diagnostic{
+			Pos:     decl.Pos(),
+			End:     decl.End(),
+			Message: fmt.Sprintf(format, concatNames(names, q)),
+			Related: []analysis.RelatedInformation{{
+				Pos:     loop.Pos(),
+				End:     loop.End(),
+				Message: "Assigned in this loop",
+			}},
+		})
+	}
+}
+
+// reportGoCaptures emits diagnostics for declarations whose variables are only read inside a single goroutine
+// function literal.
+func reportGoCaptures(ctx context.Context, p *analysis.Pass, in *inspector.Inspector, currentFile astutil.CurrentFile, goCaptures []usage.GoCapture, q QuoteStyle) {
+	defer trace.StartRegion(ctx, "ReportGoCaptures").End()
+
+	for _, capture := range goCaptures {
+		decl := capture.Decl.Node(in)
+		if currentFile.NoLintComment(decl.Pos()) {
+			continue
+		}
+
+		format := "Variable %s is only used in a goroutine and can be passed as a parameter (sg:capture)"
+		if len(capture.Vars) > 1 {
+			format = "Variables %s are only used in a goroutine and can be passed as parameters (sg:capture)"
+		}
+
+		goStmt := capture.Go.Node(in)
+
+		p.Report(analysis.Diagnostic{
+			Pos:     decl.Pos(),
+			End:     decl.End(),
+			Message: fmt.Sprintf(format, concatNames(varNames(capture.Vars), q)),
+			Related: []analysis.RelatedInformation{{
+				Pos:     goStmt.Pos(),
+				End:     goStmt.End(),
+				Message: "Captured by this goroutine",
+			}},
+		})
+	}
+}
+
+// reportInlineRanges emits diagnostics for short variable declarations only used as the range expression
+// of the following loop. No fixes are suggested, leaving it to the author whether the named value aids readability.
+func reportInlineRanges(ctx context.Context, p *analysis.Pass, in *inspector.Inspector, currentFile astutil.CurrentFile, inlineRanges []target.InlineRange, q QuoteStyle) {
+	defer trace.StartRegion(ctx, "ReportInlineRanges").End()
+
+	for _, inline := range inlineRanges {
+		decl := inline.Decl.Node(in)
+		if currentFile.NoLintComment(decl.Pos()) {
+			continue
+		}
+
+		rangeStmt := inline.Range.Node(in)
+
+		p.Report(analysis.Diagnostic{
+			Pos:     decl.Pos(),
+			End:     decl.End(),
+			Message: fmt.Sprintf("Variable %s is only used as a range expression and can be inlined (sg:inline-range)", q.quote(inline.Var.Name())),
+			Related: []analysis.RelatedInformation{{
+				Pos:     rangeStmt.Pos(),
+				End:     rangeStmt.End(),
+				Message: "Ranged over by this loop",
+			}},
+		})
+	}
+}
+
+// reportTSUnused emits diagnostics for type switch case clauses not reading the bound variable.
+// No fixes are suggested, since the binding is shared by all clauses.
+func reportTSUnused(ctx context.Context, p *analysis.Pass, in *inspector.Inspector, currentFile astutil.CurrentFile, tsUnused []target.TypeSwitchUnused, q QuoteStyle) {
+	defer trace.StartRegion(ctx, "ReportTSUnused").End()
+
+	for _, unused := range tsUnused {
+		clause, ok := unused.Clause.Node(in).(*ast.CaseClause)
+		if !ok || currentFile.NoLintComment(clause.Pos()) {
+			continue
+		}
+
+		p.Report(analysis.Diagnostic{
+			Pos:     clause.Case,
+			End:     clause.Colon + 1,
+			Message: fmt.Sprintf("Type switch variable %s is unused in this case (sg:ts-unused)", q.quote(unused.Var.Name())),
+			Related: []analysis.RelatedInformation{{
+				Pos:     unused.Bind.Pos(),
+				End:     unused.Bind.End(),
+				Message: "Bound by this type switch",
+			}},
+		})
+	}
+}
+
+// reportCondInlines emits diagnostics for if statement initializers whose variable is only used once
+// in the condition. No fixes are suggested, leaving it to the author whether the named value aids readability.
+func reportCondInlines(ctx context.Context, p *analysis.Pass, in *inspector.Inspector, currentFile astutil.CurrentFile, condInlines []target.CondInline, q QuoteStyle) {
+	defer trace.StartRegion(ctx, "ReportCondInlines").End()
+
+	for _, inline := range condInlines {
+		ifStmt, ok := inline.If.Node(in).(*ast.IfStmt)
+		if !ok || currentFile.NoLintComment(ifStmt.Pos()) {
+			continue
+		}
+
+		p.Report(analysis.Diagnostic{
+			Pos:     ifStmt.Init.Pos(),
+			End:     ifStmt.Init.End(),
+			Message: fmt.Sprintf("Variable %s is only used in the if condition and can be inlined (sg:cond-inline)", q.quote(inline.Var.Name())),
+			Related: []analysis.RelatedInformation{{
+				Pos:     inline.Use.Pos(),
+				End:     inline.Use.End(),
+				Message: "Only use in the condition",
+			}},
+		})
+	}
+}
+
+// reportSwaps emits diagnostics for moves blocked by a single statement directly following the declaration,
+// suggesting to swap both statements.
+//
+// Fixes are only suggested for inert declarations, since swapping would otherwise reorder side effects.
+// If fixes is false or the swap conflicts with other edits, suggested fixes are suppressed.
+// Returns the text edits of all suggested fixes.
+func reportSwaps(ctx context.Context, p *analysis.Pass, in *inspector.Inspector, currentFile astutil.CurrentFile, swaps []target.Swap, fixes bool, edits []analysis.TextEdit, q QuoteStyle) []analysis.TextEdit {
+	defer trace.StartRegion(ctx, "ReportSwaps").End()
+
+	var allEdits []analysis.TextEdit
+
+	for _, swap := range swaps {
+		decl, stmt := swap.Move.Decl.Node(in), swap.Stmt.Node(in)
+		if currentFile.NoLintComment(decl.Pos()) {
+			continue
+		}
+
+		varNames := usedNames(in, swap.Move.MovableDecl)
+
+		format := "Variable %s can be moved to tighter %s scope after swapping with the following statement (sg:swap)"
+		if len(varNames) > 1 {
+			format = "Variables %s can be moved to tighter %s scope after swapping with the following statement (sg:swap)"
+		}
+
+		targetName := scope.Name(swap.Move.TargetNode)
+		message := fmt.Sprintf(format, concatNames(varNames, q), targetName)
+		diagnostic := analysis.Diagnostic{
+			Pos:     decl.Pos(),
+			End:     decl.End(),
+			Message: message,
+			Related: []analysis.RelatedInformation{
+				{Pos: stmt.Pos(), End: stmt.End(), Message: "Blocking statement to swap with"},
+				{Pos: swap.Move.TargetNode.Pos(), Message: fmt.Sprintf("To this %s scope", targetName)},
+			},
+		}
+
+		if fixes && swap.Inert {
+			if swapEdits := swapStatements(p, decl, stmt); len(swapEdits) > 0 && !conflicting(swapEdits, edits) {
+				diagnostic.SuggestedFixes = []analysis.SuggestedFix{{Message: message, TextEdits: swapEdits}}
+				allEdits = append(allEdits, swapEdits...)
+			}
+		}
+
+		p.Report(diagnostic)
+	}
+
+	return allEdits
+}
+
+// reportIntroBlocks emits diagnostics for declarations whose variables are only used by the statements
+// directly following them, suggesting to wrap them in a new block.
+//
+// If fixes is false or the new block conflicts with other edits, suggested fixes are suppressed.
+// With indent, the wrapped statements are indented as with [config.IndentFix].
+// Returns the text edits of all suggested fixes.
+func reportIntroBlocks(ctx context.Context, p *analysis.Pass, in *inspector.Inspector, currentFile astutil.CurrentFile, blocks []target.IntroBlock, fixes, indent bool, edits []analysis.TextEdit, q QuoteStyle) []analysis.TextEdit {
+	defer trace.StartRegion(ctx, "ReportIntroBlocks").End()
+
+	var (
+		allEdits []analysis.TextEdit
+		wrapped  [][2]token.Pos // Ranges of the new blocks
+	)
+
+	for _, block := range blocks {
+		decl, last := block.Decl.Node(in), block.Last.Node(in)
+		if currentFile.NoLintComment(decl.Pos()) {
+			continue
+		}
+
+		format := "Variable %s can be scoped by introducing a block (sg:intro-block)"
+		if len(block.Vars) > 1 {
+			format = "Variables %s can be scoped by introducing a block (sg:intro-block)"
+		}
+
+		message := fmt.Sprintf(format, concatNames(varNames(block.Vars), q))
+		diagnostic := analysis.Diagnostic{
+			Pos:     decl.Pos(),
+			End:     decl.End(),
+			Message: message,
+			Related: []analysis.RelatedInformation{{
+				Pos:     last.Pos(),
+				End:     last.End(),
+				Message: "Last statement of the block",
+			}},
+		}
+
+		if fixes {
+			// New blocks enclosing this one indent it further
+			depth := 0
+			for _, outer := range wrapped {
+				if outer[0] <= decl.Pos() && decl.Pos() < outer[1] {
+					depth++
+				}
+			}
+
+			if blockEdits := introBlockEdits(p, blockStatements(in, block), indent, depth); len(blockEdits) > 0 && !conflicting(blockEdits, edits) {
+				diagnostic.SuggestedFixes = []analysis.SuggestedFix{{Message: message, TextEdits: blockEdits}}
+				allEdits = append(allEdits, blockEdits...)
+				wrapped = append(wrapped, [2]token.Pos{blockEdits[0].Pos, blockEdits[len(blockEdits)-1].Pos})
+			}
+		}
+
+		p.Report(diagnostic)
+	}
+
+	return allEdits
+}
+
+// blockStatements returns the statements from the declaration to the last statement of a new block.
+func blockStatements(in *inspector.Inspector, block target.IntroBlock) []ast.Node {
+	last := block.Last.Node(in)
+
+	var stmts []ast.Node
+	for c, ok := block.Decl.Cursor(in), true; ok; c, ok = c.NextSibling() {
+		stmts = append(stmts, c.Node())
+		if c.Node() == last {
+			break
+		}
+	}
+
+	return stmts
+}
+
+// reportJoins emits diagnostics for adjacent short variable declarations that can be joined into a single one.
+//
+// If fixes is false or the joined declarations conflict with other edits, suggested fixes are suppressed.
+// Returns the text edits of all suggested fixes.
+func reportJoins(ctx context.Context, p *analysis.Pass, in *inspector.Inspector, currentFile astutil.CurrentFile, joins []target.Join, fixes bool, edits []analysis.TextEdit, q QuoteStyle) []analysis.TextEdit {
+	defer trace.StartRegion(ctx, "ReportJoins").End()
+
+	var allEdits []analysis.TextEdit
+
+	for _, join := range joins {
+		stmts := make([]*ast.AssignStmt, 0, len(join.Decls))
+		for _, decl := range join.Decls {
+			if stmt, ok := decl.Node(in).(*ast.AssignStmt); ok {
+				stmts = append(stmts, stmt)
+			}
+		}
+
+		if len(stmts) < 2 {
+			continue
+		}
+
+		first, last := stmts[0], stmts[len(stmts)-1]
+
+		message := fmt.Sprintf("Variables %s can be joined into a single declaration (sg:join)", concatNames(joinedNames(in, join), q))
+		diagnostic := analysis.Diagnostic{
+			Pos:     first.Pos(),
+			End:     last.End(),
+			Message: message,
+		}
+
+		if fixes {
+			if joinEdits := joinDecls(p, stmts); len(joinEdits) > 0 && !conflicting(joinEdits, edits) {
+				diagnostic.SuggestedFixes = []analysis.SuggestedFix{{Message: message, TextEdits: joinEdits}}
+				allEdits = append(allEdits, joinEdits...)
+			}
+		}
+
+		p.Report(diagnostic)
+	}
+
+	return allEdits
+}
+
+// reportUsedAfterShadow emits diagnostics for variables used after previously shadowed.
+//
+// Shadowed variables are renamed by renamer, if not nil. Without a unique name or when the rename would conflict with
+// other edits, the diagnostic is reported without a fix.
+func reportUsedAfterShadow(ctx context.Context, p *analysis.Pass, currentFile astutil.CurrentFile, fdecl inspector.Cursor, shadows []usage.ShadowUse, renamer *Renamer, edits []analysis.TextEdit, q QuoteStyle) {
+	defer trace.StartRegion(ctx, "ReportShadowed").End()
 
 	in := fdecl.Inspector()
 
@@ -131,56 +746,167 @@ func reportUsedAfterShadow(ctx context.Context, p *analysis.Pass, currentFile as
 		}
 
 		suggestedFixes := renamer.Renames(p.TypesInfo, fdecl, shadowed.Var)
-
-		if len(suggestedFixes) > 0 {
-			hadFixes = true
+		if slices.ContainsFunc(suggestedFixes, func(fix analysis.SuggestedFix) bool { return conflicting(fix.TextEdits, edits) }) {
+			suggestedFixes = nil
 		}
 
 		name, decl := shadowed.Var.Name(), shadowed.Decl.Node(in)
 		p.Report(analysis.Diagnostic{
 			Pos:            use.Pos(),
 			End:            use.End(),
-			Message:        fmt.Sprintf("Identifier '%s' used after previously shadowed (sg:uas)", name),
+			Message:        fmt.Sprintf("Identifier %s used after previously shadowed (sg:uas)", q.quote(name)),
 			Related:        []analysis.RelatedInformation{{Pos: decl.Pos(), End: decl.Pos(), Message: "After this declaration"}},
 			SuggestedFixes: suggestedFixes,
 		})
 	}
+}
+
+// conflicting reports whether any of the edits overlaps or touches one of the others.
+func conflicting(edits, others []analysis.TextEdit) bool {
+	for _, e := range edits {
+		for _, o := range others {
+			if e.Pos <= editEnd(o) && o.Pos <= editEnd(e) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// editEnd returns the end of an edit, which is its position for insertions without an end.
+func editEnd(e analysis.TextEdit) token.Pos {
+	if e.End < e.Pos {
+		return e.Pos
+	}
+
+	return e.End
+}
+
+// createMessage constructs the diagnostic message components and related information.
+//
+// If group is true, variables of absorbed declarations are included.
+// If snippet is true, the related information for the target quotes the first line of the target scope.
+func createMessage(p *analysis.Pass, in *inspector.Inspector, move target.MoveTarget, group, snippet bool) (message moveMessage, related []analysis.RelatedInformation) {
+	if move.TargetNode == nil {
+		return moveMessage{names: move.Unused, status: move.Status}, nil
+	}
+
+	varNames := usedNames(in, move.MovableDecl)
+
+	targetName := scope.Name(move.TargetNode)
+	targetMessage := fmt.Sprintf("To this %s scope", targetName)
+	if snippet {
+		if line, ok := sourceLine(p, move.TargetNode.Pos()); ok {
+			targetMessage += fmt.Sprintf(": `%s`", line)
+		}
+	}
+
+	related = []analysis.RelatedInformation{{Pos: move.TargetNode.Pos(), Message: targetMessage}}
+
+	if group {
+		for _, absorbed := range move.AbsorbedDecls {
+			varNames = append(varNames, usedNames(in, absorbed)...)
+
+			node := absorbed.Decl.Node(in)
+			related = append(related, analysis.RelatedInformation{Pos: node.Pos(), End: node.End(), Message: "Combined with this declaration"})
+		}
+	}
 
-	return hadFixes
+	return moveMessage{names: varNames, scope: targetName, status: move.Status}, related
 }
 
-// createMessage constructs the diagnostic message and related information.
-func createMessage(in *inspector.Inspector, move target.MoveTarget) (message string, related []analysis.RelatedInformation) {
-	switch move.TargetNode {
-	case nil:
-		format := "Variable %s is unused and can be removed (sg:%s)"
-		if len(move.Unused) > 1 {
-			format = "Variables %s are unused and can be removed (sg:%s)"
+// unusedCauses returns related information explaining why variables are unused.
+func unusedCauses(in *inspector.Inspector, causes []target.UnusedCause, q QuoteStyle) []analysis.RelatedInformation {
+	related := make([]analysis.RelatedInformation, 0, len(causes))
+
+	for _, cause := range causes {
+		format := "Variable %s is reassigned here before being read"
+		if cause.Moved {
+			format = "Variable %s becomes unused after moving this declaration"
 		}
 
-		allNames := concatNames(move.Unused)
+		node := cause.Decl.Node(in)
+		related = append(related, analysis.RelatedInformation{Pos: node.Pos(), End: node.End(), Message: fmt.Sprintf(format, q.quote(cause.Name))})
+	}
+
+	return related
+}
+
+// maxSnippetLen is the maximum number of characters quoted from a target scope.
+const maxSnippetLen = 40
 
-		return fmt.Sprintf(format, allNames, move.Status), nil
+// sourceLine returns the trimmed source line containing pos, truncated to [maxSnippetLen] characters.
+func sourceLine(p *analysis.Pass, pos token.Pos) (string, bool) {
+	tf, src, ok := fileSource(p, pos)
+	if !ok {
+		return "", false
+	}
+
+	line := src[tf.Offset(tf.LineStart(tf.Line(pos))):]
+	if i := bytes.IndexByte(line, '\n'); i >= 0 {
+		line = line[:i]
+	}
+
+	snippet := strings.TrimSpace(string(line))
+	if utf8.RuneCountInString(snippet) > maxSnippetLen {
+		snippet = string([]rune(snippet)[:maxSnippetLen-1]) + "…"
+	}
+
+	return snippet, snippet != ""
+}
+
+// lazyEvaluation reports whether moving the declaration to the target avoids evaluating a call in its initializer
+// on paths not using the variables, because the target scope is only conditionally executed.
+//
+// Init fields and plain blocks are executed whenever the declaration would have been, loop bodies possibly repeatedly.
+func lazyEvaluation(info *types.Info, in *inspector.Inspector, decl, targetNode ast.Node) bool {
+	switch targetNode.(type) {
+	case *ast.CaseClause, *ast.CommClause:
+
+	case *ast.BlockStmt:
+		c, ok := in.Root().FindNode(targetNode)
+		if !ok {
+			return false
+		}
+
+		if kind, _ := c.ParentEdge(); kind != edge.IfStmt_Body && kind != edge.IfStmt_Else {
+			return false
+		}
 
 	default:
-		node := move.Decl.Node(in)
-		varNames := collectNames(node)
+		return false
+	}
 
-		if len(move.Unused) > 0 {
-			varNames = slices.DeleteFunc(varNames, func(name string) bool { return slices.Contains(move.Unused, name) })
+	switch n := decl.(type) {
+	case *ast.AssignStmt:
+		return check.HasCall(info, n.Rhs)
+
+	case *ast.DeclStmt:
+		gen, ok := n.Decl.(*ast.GenDecl)
+		if !ok {
+			return false
 		}
 
-		format := "Variable %s can be moved to tighter %s scope (sg:%s)"
-		if len(varNames) > 1 {
-			format = "Variables %s can be moved to tighter %s scope (sg:%s)"
+		for _, spec := range gen.Specs {
+			if vspec, ok := spec.(*ast.ValueSpec); ok && check.HasCall(info, vspec.Values) {
+				return true
+			}
 		}
+	}
+
+	return false
+}
 
-		allNames := concatNames(varNames)
-		targetName := scope.Name(move.TargetNode)
+// usedNames returns the names of the variables in a declaration that are not unused.
+func usedNames(in *inspector.Inspector, decl target.MovableDecl) []string {
+	varNames := collectNames(decl.Decl.Node(in))
 
-		return fmt.Sprintf(format, allNames, targetName, move.Status),
-			[]analysis.RelatedInformation{{Pos: move.TargetNode.Pos(), Message: fmt.Sprintf("To this %s scope", targetName)}}
+	if len(decl.Unused) > 0 {
+		varNames = slices.DeleteFunc(varNames, func(name string) bool { return slices.Contains(decl.Unused, name) })
 	}
+
+	return varNames
 }
 
 // collectNames extracts variable names from a declaration statement.
@@ -210,8 +936,8 @@ func collectNames(stmt ast.Node) []string {
 	return []string{"<unknown>"}
 }
 
-// concatNames formats a list of variable names into a human-readable string (e.g., "'a', 'b' and 'c'").
-func concatNames(varNames []string) string {
+// concatNames formats a list of variable names quoted in style q into a human-readable string (e.g., "'a', 'b' and 'c'").
+func concatNames(varNames []string, q QuoteStyle) string {
 	var allNames strings.Builder
 
 	for i, name := range varNames {
@@ -226,9 +952,7 @@ func concatNames(varNames []string) string {
 			allNames.WriteString(separator) // ignore error
 		}
 
-		allNames.WriteByte('\'')   // ignore error
-		allNames.WriteString(name) // ignore error
-		allNames.WriteByte('\'')   // ignore error
+		allNames.WriteString(q.quote(name)) // ignore error
 	}
 
 	return allNames.String()
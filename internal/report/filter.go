@@ -0,0 +1,178 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"slices"
+
+	"golang.org/x/tools/go/ast/inspector"
+
+	"fillmore-labs.com/scopeguard/internal/astutil"
+	"fillmore-labs.com/scopeguard/internal/target"
+	"fillmore-labs.com/scopeguard/internal/usage"
+)
+
+// Filter selects the findings that are reported.
+type Filter struct {
+	// Baseline, if set, holds accepted findings that are not reported.
+	Baseline *Baseline
+
+	// Lines, if set, restricts findings to declarations within the given line ranges.
+	Lines LineRanges
+}
+
+// LineRange is an inclusive range of lines, starting at 1.
+type LineRange struct {
+	Start, End int
+}
+
+// LineRanges maps file paths to line ranges.
+//
+// Keys match files with the given path suffix, so paths relative to the repository root can be used.
+type LineRanges map[string][]LineRange
+
+// Contains reports whether the position is within one of the line ranges.
+// All positions are contained in nil line ranges.
+func (l LineRanges) Contains(position token.Position) bool {
+	if l == nil {
+		return true
+	}
+
+	filename := filepath.ToSlash(position.Filename)
+
+	for path, ranges := range l {
+		if !matchesFile(filename, filepath.ToSlash(path)) {
+			continue
+		}
+
+		if slices.ContainsFunc(ranges, func(r LineRange) bool {
+			return r.Start <= position.Line && position.Line <= r.End
+		}) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// apply removes findings accepted by the baseline or outside the line ranges.
+func (f Filter) apply(fset *token.FileSet, in *inspector.Inspector, diagnostics Diagnostics) Diagnostics {
+	if f.Baseline == nil && f.Lines == nil {
+		return diagnostics
+	}
+
+	drop := func(pos token.Pos, names ...string) bool {
+		position := fset.Position(pos)
+
+		return f.Baseline.Accepted(position, names...) || !f.Lines.Contains(position)
+	}
+
+	diagnostics.Moves = slices.DeleteFunc(diagnostics.Moves, func(m target.MoveTarget) bool {
+		node := m.Decl.Node(in)
+
+		return drop(node.Pos(), declaredNames(node)...)
+	})
+	diagnostics.Joins = slices.DeleteFunc(diagnostics.Joins, func(j target.Join) bool {
+		node := j.Decls[0].Node(in)
+
+		return drop(node.Pos(), joinedNames(in, j)...)
+	})
+	diagnostics.InlineRanges = slices.DeleteFunc(diagnostics.InlineRanges, func(r target.InlineRange) bool {
+		return drop(r.Decl.Node(in).Pos(), r.Var.Name())
+	})
+	diagnostics.CondInlines = slices.DeleteFunc(diagnostics.CondInlines, func(c target.CondInline) bool {
+		return drop(c.If.Node(in).Pos(), c.Var.Name())
+	})
+	diagnostics.Swaps = slices.DeleteFunc(diagnostics.Swaps, func(s target.Swap) bool {
+		node := s.Move.Decl.Node(in)
+
+		return drop(node.Pos(), declaredNames(node)...)
+	})
+	diagnostics.IntroBlocks = slices.DeleteFunc(diagnostics.IntroBlocks, func(b target.IntroBlock) bool {
+		return drop(b.Decl.Node(in).Pos(), varNames(b.Vars)...)
+	})
+	diagnostics.TSUnused = slices.DeleteFunc(diagnostics.TSUnused, func(u target.TypeSwitchUnused) bool {
+		return drop(u.Clause.Node(in).Pos(), u.Var.Name())
+	})
+	diagnostics.Nested = slices.DeleteFunc(diagnostics.Nested, func(n usage.NestedAssign) bool {
+		return drop(n.Ident.Pos(), n.Ident.Name)
+	})
+	diagnostics.Shadows = slices.DeleteFunc(diagnostics.Shadows, func(s usage.ShadowUse) bool {
+		return drop(s.Use.Node(in).Pos(), s.Var.Name())
+	})
+	diagnostics.LoopShadows = slices.DeleteFunc(diagnostics.LoopShadows, func(s usage.LoopShadow) bool {
+		return drop(s.Var.Pos(), s.Var.Name())
+	})
+	diagnostics.DeadInits = slices.DeleteFunc(diagnostics.DeadInits, func(d usage.DeadInit) bool {
+		return drop(d.Decl.Node(in).Pos(), varNames(d.Vars)...)
+	})
+	diagnostics.BranchInits = slices.DeleteFunc(diagnostics.BranchInits, func(d usage.BranchInit) bool {
+		return drop(d.Decl.Node(in).Pos(), varNames(d.Vars)...)
+	})
+	diagnostics.LoopLasts = slices.DeleteFunc(diagnostics.LoopLasts, func(d usage.LoopLast) bool {
+		return drop(d.Decl.Node(in).Pos(), varNames(d.Vars)...)
+	})
+	diagnostics.NoopShadows = slices.DeleteFunc(diagnostics.NoopShadows, func(d usage.NoopShadow) bool {
+		return drop(d.Decl.Node(in).Pos(), varNames(d.Vars)...)
+	})
+	diagnostics.LoopConsts = slices.DeleteFunc(diagnostics.LoopConsts, func(d usage.LoopConst) bool {
+		return drop(d.Decl.Node(in).Pos(), d.Var.Name())
+	})
+	diagnostics.GoCaptures = slices.DeleteFunc(diagnostics.GoCaptures, func(d usage.GoCapture) bool {
+		return drop(d.Decl.Node(in).Pos(), varNames(d.Vars)...)
+	})
+
+	return diagnostics
+}
+
+// declaredNames returns the names declared by a movable declaration.
+func declaredNames(node ast.Node) []string {
+	switch n := node.(type) {
+	case *ast.AssignStmt:
+		return slices.Collect(astutil.AllAssignedNames(n))
+
+	case *ast.DeclStmt:
+		return slices.Collect(astutil.AllDeclaredNames(n))
+
+	default:
+		return nil
+	}
+}
+
+// joinedNames returns the names declared by the declarations of a join.
+func joinedNames(in *inspector.Inspector, j target.Join) []string {
+	var names []string
+	for _, decl := range j.Decls {
+		names = append(names, declaredNames(decl.Node(in))...)
+	}
+
+	return names
+}
+
+// varNames returns the names of the variables.
+func varNames(vars []*types.Var) []string {
+	names := make([]string, len(vars))
+	for i, v := range vars {
+		names[i] = v.Name()
+	}
+
+	return names
+}
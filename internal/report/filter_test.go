@@ -0,0 +1,58 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	"go/token"
+	"testing"
+
+	. "fillmore-labs.com/scopeguard/internal/report"
+)
+
+func TestLineRangesContains(t *testing.T) {
+	t.Parallel()
+
+	lines := LineRanges{
+		"pkg/file.go": {{Start: 10, End: 12}, {Start: 20, End: 20}},
+	}
+
+	tests := []struct {
+		name     string
+		lines    LineRanges
+		position token.Position
+		expected bool
+	}{
+		{"start", lines, token.Position{Filename: "/root/pkg/file.go", Line: 10}, true},
+		{"end", lines, token.Position{Filename: "/root/pkg/file.go", Line: 12}, true},
+		{"between", lines, token.Position{Filename: "/root/pkg/file.go", Line: 15}, false},
+		{"single", lines, token.Position{Filename: "/root/pkg/file.go", Line: 20}, true},
+		{"other file", lines, token.Position{Filename: "/root/pkg/other.go", Line: 10}, false},
+		{"partial path", lines, token.Position{Filename: "/root/xpkg/file.go", Line: 10}, false},
+		{"empty", LineRanges{}, token.Position{Filename: "/root/pkg/file.go", Line: 10}, false},
+		{"nil", nil, token.Position{Filename: "/root/pkg/file.go", Line: 10}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.lines.Contains(tt.position); got != tt.expected {
+				t.Errorf("Contains(%v) = %v, want %v", tt.position, got, tt.expected)
+			}
+		})
+	}
+}
@@ -22,7 +22,9 @@ import (
 	"go/ast"
 	"go/printer"
 	"go/token"
+	"go/types"
 	"slices"
+	"strings"
 
 	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/ast/edge"
@@ -30,19 +32,28 @@ import (
 
 	"fillmore-labs.com/scopeguard/internal/astutil"
 	"fillmore-labs.com/scopeguard/internal/target"
+	"fillmore-labs.com/scopeguard/internal/target/check"
 )
 
 var rawcfg = &printer.Config{Mode: printer.RawFormat}
 
 // createEdits creates a suggested fix to move a variable declaration to a tighter scope.
-func createEdits(p *analysis.Pass, in *inspector.Inspector, move target.MoveTarget) []analysis.TextEdit {
+//
+// With simplify, var declarations with redundant types are rewritten as short variable declarations.
+// Declarations moved to the start of a block or clause on their own line are preceded by comment, if not empty.
+// With indent, declarations moved to blocks or clauses are indented like the statements of the target block.
+func createEdits(p *analysis.Pass, in *inspector.Inspector, move target.MoveTarget, simplify, indent bool, comment string) []analysis.TextEdit {
 	stmt := move.Decl.Node(in)
 
 	// Get the bounds of the original statement (including comments)
-	pos, end := statementBounds(stmt)
+	pos, end := removalBounds(p, stmt)
 
 	// Handle delete-only case (unused variable removal)
 	if move.TargetNode == nil {
+		if len(move.Silencers) > 0 {
+			return removeSilenced(p, in, stmt, move)
+		}
+
 		return removeUnused(stmt, move.Unused)
 	}
 
@@ -56,23 +67,41 @@ func createEdits(p *analysis.Pass, in *inspector.Inspector, move target.MoveTarg
 		buf           bytes.Buffer
 		extraRemovals []analysis.TextEdit
 		err           error
+		prefix        string
 	)
 
+	if indent && info.needsNewline {
+		prefix = blockIndent(p, info.open)
+	}
+
 	// Build the declaration text with appropriate formatting
 	if info.needsNewline {
-		buf.WriteByte('\n') // ignore error
+		buf.WriteByte('\n')     // ignore error
+		buf.WriteString(prefix) // ignore error
+
+		if comment != "" && !info.needsSemicolon {
+			buf.WriteString(comment) // ignore error
+			buf.WriteByte('\n')      // ignore error
+			buf.WriteString(prefix)  // ignore error
+		}
 	} else {
 		buf.WriteByte(' ') // ignore error
 	}
 
+	start := buf.Len()
+
 	switch stmt := stmt.(type) {
 	case *ast.AssignStmt:
 		// Insert the statement (wrap composite literals if moving to the Init field)
 		// Combine with additional declarations if present
-		extraRemovals, err = fprintAssign(&buf, in, p.Fset, move, stmt, info.moveToInit)
+		extraRemovals, err = fprintAssign(&buf, p, in, move, stmt, info.moveToInit)
 
 	case *ast.DeclStmt:
-		err = fprintDecl(&buf, p.Fset, stmt, move.Unused)
+		if asgn, comment, ok := shortVarDecl(p, stmt, move.Unused); simplify && ok && (comment == nil || !info.needsSemicolon) {
+			err = fprintShortVarDecl(&buf, p.Fset, asgn, comment)
+		} else {
+			err = fprintDecl(&buf, p.Fset, stmt, move.Unused)
+		}
 
 	default:
 		err = rawcfg.Fprint(&buf, p.Fset, stmt)
@@ -84,9 +113,21 @@ func createEdits(p *analysis.Pass, in *inspector.Inspector, move target.MoveTarg
 		return nil
 	}
 
-	if info.needsSemicolon {
+	if prefix != "" && !multilineRawString(stmt) {
+		// Indent continuation lines of multi-line declarations
+		decl := bytes.ReplaceAll(buf.Bytes()[start:], []byte("\n"), []byte("\n"+prefix))
+		buf.Truncate(start)
+		buf.Write(decl) // ignore error
+	}
+
+	switch {
+	case info.needsSemicolon:
 		buf.WriteByte(';') // ignore error
-	} else {
+
+	case prefix != "" && lineEnd(p, info.pos):
+		// The indented declaration ends the line in a block, no separator needed
+
+	default:
 		buf.WriteByte(' ') // ignore error
 	}
 
@@ -126,6 +167,61 @@ func statementBounds(stmt ast.Node) (pos, end token.Pos) {
 	return pos, end
 }
 
+// removalBounds returns the range to delete when moving a statement away.
+//
+// When nothing else is on the statement's lines, the range is extended to include the indentation
+// and the trailing newline, so the removal does not leave a blank line that gofmt would otherwise
+// have to clean up.
+func removalBounds(p *analysis.Pass, stmt ast.Node) (token.Pos, token.Pos) {
+	pos, end := statementBounds(stmt)
+
+	tf, src, ok := fileSource(p, pos)
+	if !ok {
+		return pos, end
+	}
+
+	start, stop := tf.Offset(pos), tf.Offset(end)
+
+	for start > 0 && isBlank(src[start-1]) {
+		start--
+	}
+
+	if start > 0 && src[start-1] != '\n' {
+		return pos, end // Other code precedes the statement
+	}
+
+	for stop < len(src) && isBlank(src[stop]) {
+		stop++
+	}
+
+	switch {
+	case stop == len(src):
+	case src[stop] == '\n':
+		stop++
+	default:
+		return pos, end // Other code or a comment follows the statement
+	}
+
+	return tf.Pos(start), tf.Pos(stop)
+}
+
+func isBlank(b byte) bool { return b == ' ' || b == '\t' || b == '\r' }
+
+// fileSource returns the file containing pos and its source, if the driver provides it.
+func fileSource(p *analysis.Pass, pos token.Pos) (*token.File, []byte, bool) {
+	tf := p.Fset.File(pos)
+	if tf == nil || p.ReadFile == nil {
+		return nil, nil, false
+	}
+
+	src, err := p.ReadFile(tf.Name())
+	if err != nil || len(src) != tf.Size() {
+		return nil, nil, false
+	}
+
+	return tf, src, true
+}
+
 // removeUnused generates text edits to delete or replace unused variables with the blank identifier '_'.
 func removeUnused(stmt ast.Node, unused []string) []analysis.TextEdit {
 	switch n := stmt.(type) {
@@ -139,6 +235,54 @@ func removeUnused(stmt ast.Node, unused []string) []analysis.TextEdit {
 	return nil
 }
 
+// removeSilenced generates text edits to delete a declaration together with the blank assignments silencing its
+// variables. Initializers with function calls are kept for their side effects as blank assignments.
+func removeSilenced(p *analysis.Pass, in *inspector.Inspector, stmt ast.Node, move target.MoveTarget) []analysis.TextEdit {
+	var edits []analysis.TextEdit
+
+	if check.HasCall(p.TypesInfo, initValues(stmt)) {
+		edits = removeUnused(stmt, move.Unused)
+		if len(edits) == 0 {
+			return nil
+		}
+	} else {
+		pos, end := removalBounds(p, stmt)
+		edits = append(edits, analysis.TextEdit{Pos: pos, End: end})
+	}
+
+	for _, silencer := range move.Silencers {
+		pos, end := removalBounds(p, silencer.Node(in))
+		edits = append(edits, analysis.TextEdit{Pos: pos, End: end})
+	}
+
+	return edits
+}
+
+// initValues returns the initializer expressions of a short variable or var declaration.
+func initValues(stmt ast.Node) []ast.Expr {
+	switch n := stmt.(type) {
+	case *ast.AssignStmt:
+		return n.Rhs
+
+	case *ast.DeclStmt:
+		gen, ok := n.Decl.(*ast.GenDecl)
+		if !ok {
+			return nil
+		}
+
+		var values []ast.Expr
+		for _, spec := range gen.Specs {
+			if vspec, ok := spec.(*ast.ValueSpec); ok {
+				values = append(values, vspec.Values...)
+			}
+		}
+
+		return values
+	}
+
+	return nil
+}
+
 // removeUnusedAssign handles removal of unused variables from assignment statements (:= and =).
 func removeUnusedAssign(n *ast.AssignStmt, unused []string) []analysis.TextEdit {
 	if n.Tok != token.DEFINE && n.Tok != token.ASSIGN {
@@ -183,12 +327,14 @@ func removeUnusedDecl(n *ast.DeclStmt, unused []string) []analysis.TextEdit {
 		edits       []analysis.TextEdit
 		allSpecs    = true
 		removeSpecs []*ast.ValueSpec
+		keepSpecs   []ast.Spec
 		underscore  = []byte("_")
 	)
 
 	for _, spec := range decl.Specs {
 		vspec, ok := spec.(*ast.ValueSpec)
 		if !ok {
+			keepSpecs = append(keepSpecs, spec)
 			continue
 		}
 
@@ -213,6 +359,7 @@ func removeUnusedDecl(n *ast.DeclStmt, unused []string) []analysis.TextEdit {
 			removeSpecs = append(removeSpecs, vspec)
 		} else {
 			allSpecs = false
+			keepSpecs = append(keepSpecs, vspec)
 
 			for _, id := range remove {
 				edits = append(edits, analysis.TextEdit{Pos: id.Pos(), End: id.End(), NewText: underscore})
@@ -220,9 +367,17 @@ func removeUnusedDecl(n *ast.DeclStmt, unused []string) []analysis.TextEdit {
 		}
 	}
 
-	if allSpecs {
+	switch {
+	case allSpecs:
 		edits = append(edits, analysis.TextEdit{Pos: decl.Pos(), End: decl.End()})
-	} else {
+
+	case len(removeSpecs) > 0 && len(keepSpecs) == 1 && collapsible(decl, keepSpecs[0]):
+		// Drop the parentheses around the remaining spec, matching gofmt
+		edits = append(edits,
+			analysis.TextEdit{Pos: decl.Lparen, End: keepSpecs[0].Pos()},
+			analysis.TextEdit{Pos: specEnd(keepSpecs[0]), End: decl.Rparen + 1})
+
+	default:
 		for _, vspec := range removeSpecs {
 			edits = append(edits, analysis.TextEdit{Pos: vspec.Pos(), End: vspec.End()})
 		}
@@ -231,9 +386,73 @@ func removeUnusedDecl(n *ast.DeclStmt, unused []string) []analysis.TextEdit {
 	return edits
 }
 
+// collapsible reports whether the parentheses of a grouped declaration can be dropped around its only remaining spec
+// without losing comments.
+func collapsible(decl *ast.GenDecl, spec ast.Spec) bool {
+	vspec, ok := spec.(*ast.ValueSpec)
+
+	return ok && decl.Lparen.IsValid() && vspec.Doc == nil
+}
+
+// specEnd returns the end of a spec, including its line comment.
+func specEnd(spec ast.Spec) token.Pos {
+	if vspec, ok := spec.(*ast.ValueSpec); ok && vspec.Comment != nil {
+		return vspec.Comment.End()
+	}
+
+	return spec.End()
+}
+
+// mergeDeadInit generates text edits to remove a declaration with dead initial values
+// and turn the overwriting assignment into the declaration.
+func mergeDeadInit(p *analysis.Pass, decl, asgn ast.Node) []analysis.TextEdit {
+	stmt, ok := asgn.(*ast.AssignStmt)
+	if !ok || stmt.Tok != token.ASSIGN {
+		return nil
+	}
+
+	pos, end := removalBounds(p, decl)
+
+	return []analysis.TextEdit{
+		{Pos: pos, End: end}, // Remove the declaration
+		{Pos: stmt.TokPos, End: stmt.TokPos + 1, NewText: []byte(token.DEFINE.String())}, // Change `=` to `:=`
+	}
+}
+
+// joinDecls generates text edits to join adjacent short variable declarations into the first one.
+//
+// The variables and values of the later declarations are appended to the first declaration,
+// which are then removed. Returns nil when the declarations can't be printed.
+func joinDecls(p *analysis.Pass, stmts []*ast.AssignStmt) []analysis.TextEdit {
+	first, rest := stmts[0], stmts[1:]
+
+	var lhs, rhs bytes.Buffer
+	for _, stmt := range rest {
+		lhs.WriteString(", ") // ignore error
+		if err := fprintAssignLHS(&lhs, p.Fset, stmt.Lhs, nil); err != nil {
+			return nil
+		}
+
+		rhs.WriteString(", ") // ignore error
+		if err := fprintAssignRHS(&rhs, p.Fset, stmt.Rhs, nil); err != nil {
+			return nil
+		}
+	}
+
+	pos, _ := removalBounds(p, rest[0])
+	_, end := removalBounds(p, rest[len(rest)-1])
+
+	return []analysis.TextEdit{
+		{Pos: first.Lhs[len(first.Lhs)-1].End(), NewText: lhs.Bytes()},
+		{Pos: first.Rhs[len(first.Rhs)-1].End(), NewText: rhs.Bytes()},
+		{Pos: pos, End: end}, // Remove the later declarations
+	}
+}
+
 // insertInfo contains all information needed to insert a declaration at a target location.
 type insertInfo struct {
 	pos            token.Pos           // Where to insert the declaration
+	open           token.Pos           // Opening token of the target block or clause
 	moveToInit     bool                // Whether moving to an Init field (vs. block scope)
 	needsNewline   bool                // Whether to add a newline before declaration
 	needsSemicolon bool                // Whether to add a semicolon after declaration
@@ -307,20 +526,26 @@ func calcInsertInfo(p *analysis.Pass, targetNode ast.Node) insertInfo {
 
 	case *ast.BlockStmt:
 		return insertInfo{
-			pos:          n.Lbrace + 1, // After the opening brace
-			needsNewline: true,
+			pos:            n.Lbrace + 1, // After the opening brace
+			open:           n.Lbrace,
+			needsNewline:   true,
+			needsSemicolon: continuesLine(p.Fset, n.Lbrace, n.List),
 		}
 
 	case *ast.CaseClause:
 		return insertInfo{
-			pos:          n.Colon + 1, // After the ':'
-			needsNewline: true,
+			pos:            n.Colon + 1, // After the ':'
+			open:           n.Case,
+			needsNewline:   true,
+			needsSemicolon: continuesLine(p.Fset, n.Colon, n.Body),
 		}
 
 	case *ast.CommClause:
 		return insertInfo{
-			pos:          n.Colon + 1, // After the ':'
-			needsNewline: true,
+			pos:            n.Colon + 1, // After the ':'
+			open:           n.Case,
+			needsNewline:   true,
+			needsSemicolon: continuesLine(p.Fset, n.Colon, n.Body),
 		}
 
 	default:
@@ -329,12 +554,76 @@ func calcInsertInfo(p *analysis.Pass, targetNode ast.Node) insertInfo {
 	}
 }
 
+// blockIndent returns the indentation of statements in a block or clause, one tab deeper than
+// the line of its opening token.
+func blockIndent(p *analysis.Pass, open token.Pos) string {
+	indent, _ := lineIndent(p, open)
+
+	return indent + "\t"
+}
+
+// lineIndent returns the leading blanks of the line containing pos and whether only blanks precede pos on its line.
+func lineIndent(p *analysis.Pass, pos token.Pos) (string, bool) {
+	tf, src, ok := fileSource(p, pos)
+	if !ok {
+		return "", false
+	}
+
+	start := tf.Offset(tf.LineStart(tf.Line(pos)))
+	stop := start
+	for stop < len(src) && isBlank(src[stop]) && src[stop] != '\r' {
+		stop++
+	}
+
+	return string(src[start:stop]), stop == tf.Offset(pos)
+}
+
+// lineEnd reports whether only blanks follow pos on its line.
+func lineEnd(p *analysis.Pass, pos token.Pos) bool {
+	tf, src, ok := fileSource(p, pos)
+	if !ok {
+		return false
+	}
+
+	stop := tf.Offset(pos)
+	for stop < len(src) && isBlank(src[stop]) {
+		stop++
+	}
+
+	return stop == len(src) || src[stop] == '\n'
+}
+
+// multilineRawString reports whether the statement contains a raw string literal spanning multiple lines,
+// whose content must not be indented.
+func multilineRawString(stmt ast.Node) bool {
+	found := false
+	ast.Inspect(stmt, func(n ast.Node) bool {
+		if lit, ok := n.(*ast.BasicLit); ok && lit.Kind == token.STRING && strings.HasPrefix(lit.Value, "`") && strings.Contains(lit.Value, "\n") {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// continuesLine reports whether the first statement of a block starts on the same line as its opening token,
+// so a declaration inserted in between must be terminated by a semicolon.
+func continuesLine(fset *token.FileSet, open token.Pos, list []ast.Stmt) bool {
+	if len(list) == 0 {
+		return false
+	}
+
+	return fset.Position(open).Line == fset.Position(list[0].Pos()).Line
+}
+
 // fprintAssign prints an assignment statement.
-func fprintAssign(buf *bytes.Buffer, in *inspector.Inspector, fset *token.FileSet, move target.MoveTarget, stmt *ast.AssignStmt, moveToInit bool) ([]analysis.TextEdit, error) {
+func fprintAssign(buf *bytes.Buffer, p *analysis.Pass, in *inspector.Inspector, move target.MoveTarget, stmt *ast.AssignStmt, moveToInit bool) ([]analysis.TextEdit, error) {
 	// If we are not moving to Init (which might require wrapping composite literals) AND we have no other decls to combine,
 	// we can use the statement as is.
 	if stmt.Tok != token.DEFINE || (!moveToInit && len(move.Unused) == 0 && len(move.AbsorbedDecls) == 0) {
-		return nil, rawcfg.Fprint(buf, fset, stmt)
+		return nil, rawcfg.Fprint(buf, p.Fset, stmt)
 	}
 
 	// We handle composite literal wrapping for the RHS if moving to Init
@@ -368,7 +657,7 @@ func fprintAssign(buf *bytes.Buffer, in *inspector.Inspector, fset *token.FileSe
 		}
 
 		// Add removal edit for this declaration
-		pos, end := statementBounds(otherNode)
+		pos, end := removalBounds(p, otherNode)
 		extraRemovals = append(extraRemovals, analysis.TextEdit{Pos: pos, End: end})
 
 		if moveToInit {
@@ -388,7 +677,7 @@ func fprintAssign(buf *bytes.Buffer, in *inspector.Inspector, fset *token.FileSe
 	}
 
 	// Manual printing of assignment to avoid spurious newlines and handle formatting
-	if err := fprintAssignLHS(buf, fset, lhs, move.Unused); err != nil {
+	if err := fprintAssignLHS(buf, p.Fset, lhs, move.Unused); err != nil {
 		return nil, err
 	}
 
@@ -396,7 +685,7 @@ func fprintAssign(buf *bytes.Buffer, in *inspector.Inspector, fset *token.FileSe
 	buf.WriteString(stmt.Tok.String()) // ignore error
 	buf.WriteByte(' ')                 // ignore error
 
-	if err := fprintAssignRHS(buf, fset, rhs, cls); err != nil {
+	if err := fprintAssignRHS(buf, p.Fset, rhs, cls); err != nil {
 		return nil, err
 	}
 
@@ -493,20 +782,138 @@ func fprintDecl(buf *bytes.Buffer, fset *token.FileSet, stmt *ast.DeclStmt, unus
 		return nil
 	}
 
+	lparen, rparen := decl.Lparen, decl.Rparen
+	if len(specs) == 1 && len(specs) < len(decl.Specs) {
+		// Drop the parentheses around the remaining spec, matching gofmt
+		lparen, rparen = token.NoPos, token.NoPos
+	}
+
 	stmt = &ast.DeclStmt{
 		Decl: &ast.GenDecl{
 			Doc:    decl.Doc,
 			TokPos: decl.TokPos,
 			Tok:    decl.Tok,
-			Lparen: decl.Lparen,
+			Lparen: lparen,
 			Specs:  specs,
-			Rparen: decl.Rparen,
+			Rparen: rparen,
 		},
 	}
 
 	return rawcfg.Fprint(buf, fset, stmt)
 }
 
+// shortVarDecl converts a var declaration with a single spec into an equivalent short variable declaration.
+//
+// This is only possible when every declared variable keeps its type, i.e. the explicit type is identical to
+// the default type of its initial value. Since type checking records untyped constants with their converted type,
+// the initial values are checked again on their own. Interface types are kept, since the initial value would change the
+// variable type to the concrete type.
+//
+// Returns the assignment and the line comment of the declaration, if any.
+func shortVarDecl(p *analysis.Pass, stmt *ast.DeclStmt, unused []string) (*ast.AssignStmt, *ast.CommentGroup, bool) {
+	decl, ok := stmt.Decl.(*ast.GenDecl)
+	if !ok || decl.Tok != token.VAR || decl.Doc != nil || len(decl.Specs) != 1 {
+		return nil, nil, false
+	}
+
+	vspec, ok := decl.Specs[0].(*ast.ValueSpec)
+	if !ok || vspec.Doc != nil || vspec.Type == nil || len(vspec.Values) == 0 {
+		return nil, nil, false
+	}
+
+	typ := p.TypesInfo.TypeOf(vspec.Type)
+	if typ == nil || types.IsInterface(typ) {
+		return nil, nil, false
+	}
+
+	valueTypes, ok := inferredTypes(p, stmt.Pos(), vspec.Values, len(vspec.Names))
+	if !ok {
+		return nil, nil, false
+	}
+
+	lhs := make([]ast.Expr, 0, len(vspec.Names))
+	used := false
+
+	for i, id := range vspec.Names {
+		if !types.Identical(types.Default(valueTypes[i]), typ) {
+			return nil, nil, false // The explicit type is load-bearing
+		}
+
+		if id.Name == "_" || slices.Contains(unused, id.Name) {
+			lhs = append(lhs, &ast.Ident{NamePos: id.NamePos, Name: "_"})
+
+			continue
+		}
+
+		lhs, used = append(lhs, id), true
+	}
+
+	if !used {
+		return nil, nil, false // No new variables on the left side
+	}
+
+	return &ast.AssignStmt{Lhs: lhs, TokPos: vspec.Type.Pos(), Tok: token.DEFINE, Rhs: vspec.Values}, vspec.Comment, true
+}
+
+// inferredTypes returns the types inferred for n variables from the values in a fresh context,
+// where a single value may be a call returning n results.
+func inferredTypes(p *analysis.Pass, pos token.Pos, values []ast.Expr, n int) ([]types.Type, bool) {
+	if len(values) == 1 && n > 1 {
+		tuple, ok := inferredType(p, pos, values[0]).(*types.Tuple)
+		if !ok || tuple.Len() != n {
+			return nil, false
+		}
+
+		valueTypes := make([]types.Type, n)
+		for i := range n {
+			valueTypes[i] = tuple.At(i).Type()
+		}
+
+		return valueTypes, true
+	}
+
+	if len(values) != n {
+		return nil, false
+	}
+
+	valueTypes := make([]types.Type, n)
+	for i, value := range values {
+		if valueTypes[i] = inferredType(p, pos, value); valueTypes[i] == nil {
+			return nil, false
+		}
+	}
+
+	return valueTypes, true
+}
+
+// inferredType returns the type of an expression evaluated on its own at pos, or nil if it can't be determined.
+func inferredType(p *analysis.Pass, pos token.Pos, expr ast.Expr) types.Type {
+	info := &types.Info{Types: make(map[ast.Expr]types.TypeAndValue)}
+	if err := types.CheckExpr(p.Fset, p.Pkg, pos, expr, info); err != nil {
+		return nil
+	}
+
+	return info.TypeOf(expr)
+}
+
+// fprintShortVarDecl prints a short variable declaration, followed by the line comment of the original declaration.
+func fprintShortVarDecl(buf *bytes.Buffer, fset *token.FileSet, asgn *ast.AssignStmt, comment *ast.CommentGroup) error {
+	if err := rawcfg.Fprint(buf, fset, asgn); err != nil {
+		return err
+	}
+
+	if comment == nil {
+		return nil
+	}
+
+	for _, c := range comment.List {
+		buf.WriteByte(' ')      // ignore error
+		buf.WriteString(c.Text) // ignore error
+	}
+
+	return nil
+}
+
 // compositeLits identifies which RHS expressions in an assignment contain [composite literals] that need parenthesization:
 //
 //	A parsing ambiguity arises when a composite literal [...] appears as an operand between the keyword and the opening brace of the block of an "if", "for", or "switch" statement, ...
@@ -528,15 +935,24 @@ func compositeLits(cls []int, c inspector.Cursor, start int) []int {
 }
 
 // NeedParent detects whether an expression contains composite literals that need parenthesization.
+//
+// In the init statement of an if, for or switch statement, the opening brace of a composite literal
+// with a type name, like T{}, &T{} or pkg.T[int]{}, would be parsed as the start of the block.
+// Such literals need to be enclosed in parentheses, brackets or braces. Literal types like []int,
+// [...]T, map[K]V or struct{} are unambiguous, so their composite literals are left as they are.
 func NeedParent(e inspector.Cursor) bool {
 	// If the expression root itself is a composite literal, it has no enclosing parents
 	// within the expression boundary to provide safe delimiters. It needs parenthesization.
-	if _, ok := e.Node().(*ast.CompositeLit); ok {
-		return true
+	if lit, ok := e.Node().(*ast.CompositeLit); ok {
+		return typeNameLit(lit)
 	}
 
 compLits:
 	for c := range e.Preorder((*ast.CompositeLit)(nil)) {
+		if !typeNameLit(c.Node().(*ast.CompositeLit)) {
+			continue
+		}
+
 		// Found a composite literal. Walk up the parent chain to check if it's already
 		// safely delimited by parentheses, block braces, or other constructs.
 		for p := c; p.Index() != e.Index(); p = p.Parent() {
@@ -561,3 +977,103 @@ compLits:
 	// No problematic composite literals found
 	return false
 }
+
+// typeNameLit reports whether the composite literal's type is a (possibly qualified or instantiated) type name.
+func typeNameLit(lit *ast.CompositeLit) bool {
+	switch ast.Unparen(lit.Type).(type) {
+	case *ast.Ident, *ast.SelectorExpr, *ast.IndexExpr, *ast.IndexListExpr:
+		return true
+
+	default:
+		return false
+	}
+}
+
+// introBlockEdits generates text edits wrapping the statements stmts in a new block,
+// with the braces on lines of their own at the indentation of the first statement.
+//
+// With indent, the wrapped lines are indented one tab deeper, like declarations moved with [config.IndentFix],
+// and depth is the number of new blocks already enclosing the statements.
+//
+// Returns nil when the source is not available or other code shares the lines of the braces.
+func introBlockEdits(p *analysis.Pass, stmts []ast.Node, indent bool, depth int) []analysis.TextEdit {
+	decl, last := stmts[0], stmts[len(stmts)-1]
+
+	prefix, ok := lineIndent(p, decl.Pos())
+	if !ok {
+		return nil
+	}
+
+	tf, src, ok := fileSource(p, last.End())
+	if !ok {
+		return nil
+	}
+
+	// Close the block at the end of the line, after a trailing comment
+	stop := tf.Offset(last.End())
+	for stop < len(src) && isBlank(src[stop]) {
+		stop++
+	}
+
+	if stop+1 < len(src) && src[stop] == '/' && src[stop+1] == '/' {
+		for stop < len(src) && src[stop] != '\n' {
+			stop++
+		}
+	}
+
+	if stop < len(src) && src[stop] != '\n' {
+		return nil // Other code follows the last statement
+	}
+
+	for stop > tf.Offset(last.End()) && isBlank(src[stop-1]) {
+		stop--
+	}
+
+	if !indent {
+		return []analysis.TextEdit{
+			{Pos: decl.Pos(), End: decl.Pos(), NewText: []byte("{\n" + prefix)},
+			{Pos: tf.Pos(stop), End: tf.Pos(stop), NewText: []byte("\n" + prefix + "}")},
+		}
+	}
+
+	prefix += strings.Repeat("\t", depth)
+
+	edits := []analysis.TextEdit{{Pos: decl.Pos(), End: decl.Pos(), NewText: []byte("{\n" + prefix + "\t")}}
+
+	// Indent the following non-empty lines, except continuation lines of multi-line raw strings
+	for i := tf.Offset(decl.Pos()); i < stop; i++ {
+		if src[i] != '\n' || src[i+1] == '\n' {
+			continue
+		}
+
+		pos := tf.Pos(i + 1)
+		if slices.ContainsFunc(stmts, func(stmt ast.Node) bool {
+			return stmt.Pos() < pos && pos < stmt.End() && multilineRawString(stmt)
+		}) {
+			continue
+		}
+
+		edits = append(edits, analysis.TextEdit{Pos: pos, End: pos, NewText: []byte("\t")})
+	}
+
+	return append(edits, analysis.TextEdit{Pos: tf.Pos(stop), End: tf.Pos(stop), NewText: []byte("\n" + prefix + "}")})
+}
+
+// swapStatements generates text edits exchanging the source of two statements.
+//
+// Returns nil when the source is not available.
+func swapStatements(p *analysis.Pass, first, second ast.Node) []analysis.TextEdit {
+	tf, src, ok := fileSource(p, first.Pos())
+	if !ok {
+		return nil
+	}
+
+	text := func(n ast.Node) []byte {
+		return slices.Clone(src[tf.Offset(n.Pos()):tf.Offset(n.End())])
+	}
+
+	return []analysis.TextEdit{
+		{Pos: first.Pos(), End: first.End(), NewText: text(second)},
+		{Pos: second.Pos(), End: second.End(), NewText: text(first)},
+	}
+}
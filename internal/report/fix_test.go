@@ -80,6 +80,51 @@ func TestNeedParent(t *testing.T) {
 			src:      "type T struct{}; f := func(t T) T { return t }; _ = f(f(T{}))",
 			expected: false,
 		},
+		{
+			name:     "AddressOf",
+			src:      `type T struct{}; _ = &T{}`,
+			expected: true,
+		},
+		{
+			name:     "SliceLit",
+			src:      `_ = []int{1, 2}`,
+			expected: false,
+		},
+		{
+			name:     "MapLit",
+			src:      `_ = map[string]int{"a": 1}`,
+			expected: false,
+		},
+		{
+			name:     "MapKey",
+			src:      `type T struct{}; _ = map[T]int{T{}: 1}`,
+			expected: false,
+		},
+		{
+			name:     "ArrayOfT",
+			src:      `type T struct{}; _ = &[...]T{{}}`,
+			expected: false,
+		},
+		{
+			name:     "SliceOfT",
+			src:      `type T struct{}; _ = []T{T{}}`,
+			expected: false,
+		},
+		{
+			name:     "Qualified",
+			src:      `_ = sync.Mutex{}`,
+			expected: true,
+		},
+		{
+			name:     "Instantiated",
+			src:      `_ = atomic.Pointer[int]{}`,
+			expected: true,
+		},
+		{
+			name:     "StructLit",
+			src:      `_ = struct{}{}`,
+			expected: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,133 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"fmt"
+	"strings"
+
+	"fillmore-labs.com/scopeguard/internal/target"
+)
+
+// moveMessage holds the components of a move diagnostic message.
+type moveMessage struct {
+	// names are the variables to move or remove.
+	names []string
+
+	// scope is the name of the target scope, or empty if the variables are unused.
+	scope string
+
+	// status is the move status.
+	status target.MoveStatus
+
+	// lazy indicates that moving avoids evaluating a call on paths not using the variables.
+	lazy bool
+
+	// addressTaken indicates that the address of a moved variable is taken.
+	addressTaken bool
+
+	// quote is the quote style for variable names.
+	quote QuoteStyle
+}
+
+// String returns the plain message.
+func (m moveMessage) String() string {
+	return m.format(plainStyle)
+}
+
+// format assembles the message, applying st to its components.
+func (m moveMessage) format(st style) string {
+	names := st.names(m.names, m.quote)
+	status := st.status(fmt.Sprintf("(sg:%s)", m.status))
+
+	if m.scope == "" {
+		format := "Variable %s is unused and can be removed %s"
+		if len(m.names) > 1 {
+			format = "Variables %s are unused and can be removed %s"
+		}
+
+		return fmt.Sprintf(format, names, status)
+	}
+
+	format := "Variable %s can be moved to tighter %s scope%s %s"
+	if len(m.names) > 1 {
+		format = "Variables %s can be moved to tighter %s scope%s %s"
+	}
+
+	var hints []string
+	if m.lazy {
+		hints = append(hints, "evaluating the initializer only when needed")
+	}
+	if m.addressTaken {
+		hints = append(hints, "shortening the lifetime of its taken address")
+	}
+
+	var hint string
+	if len(hints) > 0 {
+		hint = ", " + strings.Join(hints, " and ")
+	}
+
+	return fmt.Sprintf(format, names, st.scope(m.scope), hint, status)
+}
+
+// style formats message components.
+type style bool
+
+const (
+	// plainStyle leaves message components unchanged.
+	plainStyle style = false
+
+	// ansiStyle highlights message components with ANSI escape sequences.
+	ansiStyle style = true
+)
+
+// ANSI escape sequences for message components.
+const (
+	ansiName   = "\x1b[1;36m" // bold cyan
+	ansiScope  = "\x1b[33m"   // yellow
+	ansiStatus = "\x1b[2m"    // faint
+	ansiReset  = "\x1b[0m"
+)
+
+func (st style) names(varNames []string, q QuoteStyle) string {
+	if st == plainStyle {
+		return concatNames(varNames, q)
+	}
+
+	colored := make([]string, len(varNames))
+	for i, name := range varNames {
+		colored[i] = ansiName + name + ansiReset
+	}
+
+	return concatNames(colored, q)
+}
+
+func (st style) scope(name string) string {
+	return st.wrap(ansiScope, name)
+}
+
+func (st style) status(code string) string {
+	return st.wrap(ansiStatus, code)
+}
+
+func (st style) wrap(seq, s string) string {
+	if st == plainStyle {
+		return s
+	}
+
+	return seq + s + ansiReset
+}
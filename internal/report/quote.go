@@ -0,0 +1,94 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidQuoteStyle is returned when parsing an unknown quote style.
+var ErrInvalidQuoteStyle = errors.New("invalid quote style")
+
+// QuoteStyle selects how variable names are quoted in diagnostic messages.
+type QuoteStyle uint8
+
+const (
+	// QuoteSingle encloses names in single quotes, like 'x'.
+	QuoteSingle QuoteStyle = iota
+
+	// QuoteBacktick encloses names in backticks, like `x`.
+	QuoteBacktick
+
+	// QuoteNone leaves names unquoted.
+	QuoteNone
+)
+
+// String returns the name of the quote style.
+func (q QuoteStyle) String() string {
+	switch q {
+	case QuoteSingle:
+		return "single"
+
+	case QuoteBacktick:
+		return "backtick"
+
+	case QuoteNone:
+		return "none"
+
+	default:
+		return fmt.Sprintf("QuoteStyle(%d)", q)
+	}
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+func (q QuoteStyle) MarshalText() ([]byte, error) {
+	return []byte(q.String()), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (q *QuoteStyle) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "single":
+		*q = QuoteSingle
+
+	case "backtick":
+		*q = QuoteBacktick
+
+	case "none":
+		*q = QuoteNone
+
+	default:
+		return fmt.Errorf("%w %q, want single, backtick or none", ErrInvalidQuoteStyle, text)
+	}
+
+	return nil
+}
+
+// quote returns the name quoted in this style.
+func (q QuoteStyle) quote(name string) string {
+	switch q {
+	case QuoteBacktick:
+		return "`" + name + "`"
+
+	case QuoteNone:
+		return name
+
+	default:
+		return "'" + name + "'"
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	"errors"
+	"testing"
+
+	. "fillmore-labs.com/scopeguard/internal/report"
+)
+
+func TestQuoteStyleText(t *testing.T) {
+	t.Parallel()
+
+	for _, q := range [...]QuoteStyle{QuoteSingle, QuoteBacktick, QuoteNone} {
+		t.Run(q.String(), func(t *testing.T) {
+			t.Parallel()
+
+			text, err := q.MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText() failed: %v", err)
+			}
+
+			var got QuoteStyle
+			if err := got.UnmarshalText(text); err != nil {
+				t.Fatalf("UnmarshalText(%q) failed: %v", text, err)
+			}
+
+			if got != q {
+				t.Errorf("UnmarshalText(%q) = %v, want %v", text, got, q)
+			}
+		})
+	}
+}
+
+func TestQuoteStyleInvalid(t *testing.T) {
+	t.Parallel()
+
+	var q QuoteStyle
+	if err := q.UnmarshalText([]byte("double")); !errors.Is(err, ErrInvalidQuoteStyle) {
+		t.Errorf("UnmarshalText(\"double\") = %v, want %v", err, ErrInvalidQuoteStyle)
+	}
+}
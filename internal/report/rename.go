@@ -17,9 +17,11 @@
 package report
 
 import (
+	"context"
 	"go/ast"
 	"go/token"
 	"go/types"
+	"log/slog"
 	"strconv"
 
 	"golang.org/x/tools/go/analysis"
@@ -33,6 +35,10 @@ import (
 // The Renamer uses lazy initialization for its internal maps, only allocating memory
 // when the first variable is renamed.
 type Renamer struct {
+	// fset is used to log positions, config limits the rename attempts.
+	fset   *token.FileSet
+	config RenameConfig
+
 	// renamed tracks variables that have already been processed to prevent duplicate renaming.
 	renamed map[*types.Var]struct{}
 
@@ -41,10 +47,22 @@ type Renamer struct {
 	count map[string]int
 }
 
+// DefaultRenameLimit is the default number of suffixes tried when renaming a variable.
+const DefaultRenameLimit = 99
+
+// RenameConfig configures the renaming of shadowed variables.
+type RenameConfig struct {
+	// Limit is the maximum number of suffixes tried for a unique name.
+	Limit int
+
+	// Logger, if set, receives a debug record when the limit is exhausted.
+	Logger *slog.Logger
+}
+
 // NewRenamer creates a new Renamer instance.
 // The actual initialization of internal maps is deferred until the first call to [Renamer.Renames].
-func NewRenamer() *Renamer {
-	return &Renamer{}
+func NewRenamer(fset *token.FileSet, config RenameConfig) *Renamer {
+	return &Renamer{fset: fset, config: config}
 }
 
 // Renames generates [analysis.SuggestedFix]s to rename a shadowed variable.
@@ -65,6 +83,8 @@ func (r *Renamer) Renames(info *types.Info, fdecl inspector.Cursor, v *types.Var
 
 	suffix, ok := r.uniqueSuffix(v.Parent(), name)
 	if !ok {
+		r.logExhausted(v)
+
 		return nil
 	}
 
@@ -118,11 +138,9 @@ func (r *Renamer) uniqueSuffix(scope *types.Scope, name string) ([]byte, bool) {
 		return nil, false
 	}
 
-	const maxTries = 99
-
 	c := r.count[name]
 
-	for range maxTries {
+	for range r.config.Limit {
 		c++
 		suffix := "_" + strconv.Itoa(c)
 
@@ -143,6 +161,18 @@ func (r *Renamer) uniqueSuffix(scope *types.Scope, name string) ([]byte, bool) {
 	return nil, false
 }
 
+// logExhausted logs a variable that can't be renamed within the configured limit.
+func (r *Renamer) logExhausted(v *types.Var) {
+	if r.config.Logger == nil || v.Name() == "_" {
+		return
+	}
+
+	r.config.Logger.LogAttrs(context.Background(), slog.LevelDebug, "Rename limit exhausted",
+		slog.String("position", r.fset.Position(v.Pos()).String()),
+		slog.String("variable", v.Name()),
+		slog.Int("limit", r.config.Limit))
+}
+
 // checkParents checks if the name is already defined in the scope or any of its parent scopes.
 func checkParents(scope *types.Scope, name string) bool {
 	for parent := scope; parent != nil; parent = parent.Parent() {
@@ -23,6 +23,12 @@ import (
 
 // Diagnostics aggregates all analysis findings for the reporting stage.
 type Diagnostics struct {
-	Moves []target.MoveTarget
+	Moves        []target.MoveTarget
+	Joins        []target.Join
+	InlineRanges []target.InlineRange
+	TSUnused     []target.TypeSwitchUnused
+	CondInlines  []target.CondInline
+	IntroBlocks  []target.IntroBlock
+	Swaps        []target.Swap
 	usage.Diagnostics
 }
@@ -0,0 +1,144 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scope
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"slices"
+	"strings"
+)
+
+// ErrInvalidKind is returned when parsing an unknown target scope kind.
+var ErrInvalidKind = errors.New("invalid scope kind")
+
+// Kind is a set of target scope kinds declarations can be moved to.
+type Kind uint8
+
+const (
+	// IfInit is the init field of an if statement.
+	IfInit Kind = 1 << iota
+
+	// ForInit is the init field of a for statement.
+	ForInit
+
+	// SwitchInit is the init field of a switch statement.
+	SwitchInit
+
+	// TypeSwitchInit is the init field of a type switch statement.
+	TypeSwitchInit
+
+	// Block is a block statement, like the body of an if statement.
+	Block
+
+	// Case is a case clause of a switch or type switch statement.
+	Case
+
+	// CommClause is a case clause of a select statement.
+	CommClause
+)
+
+const (
+	// AllKinds contains all target scope kinds.
+	AllKinds = IfInit | ForInit | SwitchInit | TypeSwitchInit | Block | Case | CommClause
+
+	// BlockKinds contains the target scope kinds of statement lists, excluding init fields.
+	BlockKinds = Block | Case | CommClause
+)
+
+// kindNames are the names of the target scope kinds, in bit order.
+var kindNames = [...]string{"if-init", "for-init", "switch-init", "type-switch-init", "block", "case", "comm-clause"}
+
+// KindOf returns the kind of a target node, or zero if it is no target scope.
+func KindOf(targetNode ast.Node) Kind {
+	switch targetNode.(type) {
+	case *ast.IfStmt:
+		return IfInit
+
+	case *ast.ForStmt:
+		return ForInit
+
+	case *ast.SwitchStmt:
+		return SwitchInit
+
+	case *ast.TypeSwitchStmt:
+		return TypeSwitchInit
+
+	case *ast.BlockStmt:
+		return Block
+
+	case *ast.CaseClause:
+		return Case
+
+	case *ast.CommClause:
+		return CommClause
+
+	default:
+		return 0
+	}
+}
+
+// Enabled reports whether all kinds of k are in the set.
+func (k Kind) Enabled(kind Kind) bool {
+	return k&kind == kind
+}
+
+// String returns the comma-separated names of the kinds in the set.
+func (k Kind) String() string {
+	var names []string
+
+	for i, name := range kindNames {
+		if k&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+
+	if unknown := k &^ AllKinds; unknown != 0 {
+		names = append(names, fmt.Sprintf("Kind(%#x)", uint8(unknown)))
+	}
+
+	return strings.Join(names, ",")
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+func (k Kind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler], parsing comma-separated kind names.
+func (k *Kind) UnmarshalText(text []byte) error {
+	var kinds Kind
+
+	for name := range strings.SplitSeq(string(text), ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		i := slices.Index(kindNames[:], name)
+		if i < 0 {
+			return fmt.Errorf("%w %q, want %s", ErrInvalidKind, name, strings.Join(kindNames[:], ", "))
+		}
+
+		kinds |= 1 << i
+	}
+
+	*k = kinds
+
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scope_test
+
+import (
+	"errors"
+	"go/ast"
+	"testing"
+
+	. "fillmore-labs.com/scopeguard/internal/scope"
+)
+
+func TestKindText(t *testing.T) {
+	t.Parallel()
+
+	for _, k := range [...]Kind{IfInit, Block | Case, AllKinds, 0} {
+		t.Run(k.String(), func(t *testing.T) {
+			t.Parallel()
+
+			text, err := k.MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText() failed: %v", err)
+			}
+
+			var got Kind
+			if err := got.UnmarshalText(text); err != nil {
+				t.Fatalf("UnmarshalText(%q) failed: %v", text, err)
+			}
+
+			if got != k {
+				t.Errorf("UnmarshalText(%q) = %v, want %v", text, got, k)
+			}
+		})
+	}
+}
+
+func TestKindOf(t *testing.T) {
+	t.Parallel()
+
+	tests := [...]struct {
+		node ast.Node
+		want Kind
+	}{
+		{&ast.IfStmt{}, IfInit},
+		{&ast.ForStmt{}, ForInit},
+		{&ast.SwitchStmt{}, SwitchInit},
+		{&ast.TypeSwitchStmt{}, TypeSwitchInit},
+		{&ast.BlockStmt{}, Block},
+		{&ast.CaseClause{}, Case},
+		{&ast.CommClause{}, CommClause},
+		{&ast.RangeStmt{}, 0},
+		{nil, 0},
+	}
+
+	for _, tt := range tests {
+		if got := KindOf(tt.node); got != tt.want {
+			t.Errorf("KindOf(%T) = %v, want %v", tt.node, got, tt.want)
+		}
+	}
+}
+
+func TestKindInvalid(t *testing.T) {
+	t.Parallel()
+
+	var k Kind
+	if err := k.UnmarshalText([]byte("block,range")); !errors.Is(err, ErrInvalidKind) {
+		t.Errorf("UnmarshalText(\"block,range\") = %v, want %v", err, ErrInvalidKind)
+	}
+}
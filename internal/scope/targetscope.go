@@ -40,6 +40,17 @@ func NewTargetScope(scopes Index) TargetScope {
 //   - Loop bodies: Variables used in multiple iterations must stay outside the loop
 //   - Function literals: Variables captured by closures must remain in the capturing scope
 func (s TargetScope) FindSafeScope(declScope, minScope *types.Scope) *types.Scope {
+	return s.findSafeScope(declScope, minScope, false)
+}
+
+// FindSafeLoopScope is like [TargetScope.FindSafeScope], but permits moves into loop bodies.
+//
+// The caller must ensure that a fresh variable per iteration does not change semantics.
+func (s TargetScope) FindSafeLoopScope(declScope, minScope *types.Scope) *types.Scope {
+	return s.findSafeScope(declScope, minScope, true)
+}
+
+func (s TargetScope) findSafeScope(declScope, minScope *types.Scope, loops bool) *types.Scope {
 	// The asymmetry between loops and functions requires a delayed update for FuncType:
 	//   - Loop scopes (*ast.ForStmt): Contains Init/Cond/Post. The Body is in an *ast.BlockStmt.
 	//   - Function scopes (*ast.FuncType): Contains parameters/result/body.
@@ -55,12 +66,20 @@ func (s TargetScope) FindSafeScope(declScope, minScope *types.Scope) *types.Scop
 		// Check the current scope for semantic boundaries
 		switch s.Index[current].(type) {
 		case *ast.ForStmt:
+			if loops {
+				break
+			}
+
 			// Variables can safely move TO the loop scope (the Init field)
 			// but cannot move INTO the loop body (would change lifetime semantics).
 			// Immediate update: this scope is the boundary
 			targetScope = current
 
 		case *ast.RangeStmt:
+			if loops {
+				break
+			}
+
 			// Variables can stay in the loop scope (the Key field)
 			// but cannot move INTO the loop body (would change lifetime semantics).
 			// Immediate update: this scope is the boundary
@@ -83,14 +102,44 @@ func (s TargetScope) FindSafeScope(declScope, minScope *types.Scope) *types.Scop
 	return nil
 }
 
+// Boundary returns the outermost loop or function literal scope node from minScope up to declScope
+// that limits the safe scope, or nil if there is none.
+//
+// Loops are ignored when loops is set, matching [TargetScope.FindSafeLoopScope].
+func (s TargetScope) Boundary(declScope, minScope *types.Scope, loops bool) ast.Node {
+	var boundary ast.Node
+
+	for current := minScope; current != nil; current = s.ParentScope(current) {
+		switch n := s.Index[current].(type) {
+		case *ast.ForStmt, *ast.RangeStmt:
+			// Declarations in the loop scope itself can't move into the body
+			if !loops {
+				boundary = n
+			}
+
+		case *ast.FuncType:
+			// Only declarations outside the function literal are captured
+			if current != declScope {
+				boundary = n
+			}
+		}
+
+		if current == declScope {
+			break
+		}
+	}
+
+	return boundary
+}
+
 // TargetNode finds a suitable node for moving a variable to a tighter scope.
 //
 // Parameters:
 //   - declScope: The scope where the variable is currently declared
 //   - targetScope: The tightest scope containing all variable uses
 //   - maxPos: Position we should not cross that blocks the move
-//   - onlyBlock: If true, only consider block scopes (not init fields)
-func (s TargetScope) TargetNode(declScope, targetScope *types.Scope, maxPos token.Pos, onlyBlock bool) ast.Node {
+//   - kinds: The kinds of target scopes to consider
+func (s TargetScope) TargetNode(declScope, targetScope *types.Scope, maxPos token.Pos, kinds Kind) ast.Node {
 	// Walk up from targetScope toward declScope, returning the first suitable node.
 	for scope := targetScope; scope != declScope; scope = scope.Parent() {
 		//  If maxPos is set, scopes starting after it are skipped.
@@ -103,57 +152,39 @@ func (s TargetScope) TargetNode(declScope, targetScope *types.Scope, maxPos toke
 			panic("Invalid scope range")
 		}
 
-		switch onlyBlock {
-		case false:
-			if canUseNode(targetNode) {
-				return targetNode
-			}
-
-		case true:
-			if canUseBlockNode(targetNode) {
-				return targetNode
-			}
+		if canUseNode(targetNode, kinds) {
+			return targetNode
 		}
 	}
 
 	return nil
 }
 
-// canUseNode determines if a variable can be moved to a given AST node.
-func canUseNode(targetNode ast.Node) bool {
+// canUseNode determines if a variable can be moved to a given AST node of one of the given kinds.
+func canUseNode(targetNode ast.Node, kinds Kind) bool {
 	switch n := targetNode.(type) {
 	case *ast.IfStmt:
-		return n.Init == nil
+		return n.Init == nil && kinds.Enabled(IfInit)
 
 	case *ast.ForStmt:
-		return n.Init == nil
+		return n.Init == nil && kinds.Enabled(ForInit)
 
 	case *ast.SwitchStmt:
-		return n.Init == nil
+		return n.Init == nil && kinds.Enabled(SwitchInit)
 
 	case *ast.TypeSwitchStmt:
-		return n.Init == nil
+		return n.Init == nil && kinds.Enabled(TypeSwitchInit)
 
-	case *ast.BlockStmt,
-		*ast.CaseClause,
-		*ast.CommClause:
-		return true
+	case *ast.BlockStmt:
+		return kinds.Enabled(Block)
 
-	// case *ast.File, *ast.FuncType, *ast.TypeSpec, *ast.RangeStmt:
-	default:
-		return false
-	}
-}
+	case *ast.CaseClause:
+		return kinds.Enabled(Case)
 
-// canUseBlockNode determines if a variable can be moved to a given AST node.
-// This is a restricted version that only considers block scopes, not init fields.
-func canUseBlockNode(targetNode ast.Node) bool {
-	switch targetNode.(type) {
-	case *ast.BlockStmt,
-		*ast.CaseClause,
-		*ast.CommClause:
-		return true
+	case *ast.CommClause:
+		return kinds.Enabled(CommClause)
 
+	// case *ast.File, *ast.FuncType, *ast.TypeSpec, *ast.RangeStmt:
 	default:
 		return false
 	}
@@ -32,9 +32,10 @@ func TestFindSafeScope(t *testing.T) {
 	t.Parallel()
 
 	tests := [...]struct {
-		name string
-		src  string
-		want ast.Node
+		name  string
+		src   string
+		loops bool
+		want  ast.Node
 	}{
 		{
 			name: "simple_block",
@@ -76,6 +77,26 @@ func TestFindSafeScope(t *testing.T) {
 			src:  `x := []int{1}; for _, v := range x { _ = v }`,
 			want: (*ast.FuncType)(nil),
 		},
+		{
+			name: "nested_range_loops",
+			src:  `x := 1; for range 3 { for range 3 { _ = x } }`,
+			want: (*ast.FuncType)(nil),
+		},
+		{
+			name: "nested_range_loops_keys",
+			src:  `x := 1; for i := range 3 { for j := range 3 { _, _, _ = i, j, x } }`,
+			want: (*ast.FuncType)(nil),
+		},
+		{
+			name: "nested_range_loops_block",
+			src:  `x := 1; for range 3 { { for range 3 { _ = x } } }`,
+			want: (*ast.FuncType)(nil),
+		},
+		{
+			name: "block_nested_range_loops",
+			src:  `x := 1; { for range 3 { for range 3 { _ = x } } }`,
+			want: (*ast.BlockStmt)(nil),
+		},
 		{
 			name: "nested_blocks",
 			src:  `x := 1; { { _ = x } }`,
@@ -131,6 +152,36 @@ func TestFindSafeScope(t *testing.T) {
 			src:  `x := 1; { _ = func() { _ = x } }`,
 			want: (*ast.BlockStmt)(nil),
 		},
+		{
+			name:  "loops_for_loop_body",
+			src:   `x := 1; for i := 0; i < 10; i++ { _ = x }`,
+			loops: true,
+			want:  (*ast.BlockStmt)(nil),
+		},
+		{
+			name:  "loops_range_loop_body",
+			src:   `x := 1; for range 10 { _ = x }`,
+			loops: true,
+			want:  (*ast.BlockStmt)(nil),
+		},
+		{
+			name:  "loops_nested_range_loops",
+			src:   `x := 1; for range 3 { for range 3 { _ = x } }`,
+			loops: true,
+			want:  (*ast.BlockStmt)(nil),
+		},
+		{
+			name:  "loops_funclit",
+			src:   `x := 1; for range 10 { _ = func() { _ = x } }`,
+			loops: true,
+			want:  (*ast.BlockStmt)(nil),
+		},
+		{
+			name:  "loops_for_loop_condition",
+			src:   `x := 1; for i := 0; i < x; i++ { }`,
+			loops: true,
+			want:  (*ast.ForStmt)(nil),
+		},
 	}
 
 	for _, tt := range tests {
@@ -145,7 +196,14 @@ func TestFindSafeScope(t *testing.T) {
 			declScope, minScope := prepareScopes(t, info, scopes, body)
 
 			ts := NewTargetScope(scopes)
-			safeScope := ts.FindSafeScope(declScope, minScope)
+
+			var safeScope *types.Scope
+			if tt.loops {
+				safeScope = ts.FindSafeLoopScope(declScope, minScope)
+			} else {
+				safeScope = ts.FindSafeScope(declScope, minScope)
+			}
+
 			node := scopes[safeScope]
 
 			if got, want := reflect.TypeOf(node), reflect.TypeOf(tt.want); got != want {
@@ -155,6 +213,79 @@ func TestFindSafeScope(t *testing.T) {
 	}
 }
 
+func TestBoundary(t *testing.T) {
+	t.Parallel()
+
+	tests := [...]struct {
+		name  string
+		src   string
+		loops bool
+		want  ast.Node
+	}{
+		{
+			name: "simple_block",
+			src:  `x := 1; { _ = x }`,
+			want: nil,
+		},
+		{
+			name: "range_loop_body",
+			src:  `x := 1; for range 10 { _ = x }`,
+			want: (*ast.RangeStmt)(nil),
+		},
+		{
+			name: "funclit",
+			src:  `x := 1; { _ = func() { _ = x } }`,
+			want: (*ast.FuncType)(nil),
+		},
+		{
+			name: "funclit_in_loop",
+			src:  `x := 1; for range 10 { _ = func() { _ = x } }`,
+			want: (*ast.RangeStmt)(nil),
+		},
+		{
+			name: "range_key",
+			src:  `for x := range 10 { _ = x }`,
+			want: (*ast.RangeStmt)(nil),
+		},
+		{
+			name: "param_in_funclit",
+			src:  `_ = func(x int) { { _ = x } }`,
+			want: nil,
+		},
+		{
+			name:  "loops_funclit_in_loop",
+			src:   `x := 1; for range 10 { _ = func() { _ = x } }`,
+			loops: true,
+			want:  (*ast.FuncType)(nil),
+		},
+		{
+			name:  "loops_range_loop_body",
+			src:   `x := 1; for range 10 { _ = x }`,
+			loops: true,
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fset, f, _, body := testsource.Parse(t, tt.src)
+			_, info := testsource.Check(t, fset, f)
+
+			scopes := NewIndex(info.Scopes)
+
+			declScope, minScope := prepareScopes(t, info, scopes, body)
+
+			node := NewTargetScope(scopes).Boundary(declScope, minScope, tt.loops)
+
+			if got, want := reflect.TypeOf(node), reflect.TypeOf(tt.want); got != want {
+				t.Errorf("Expected %s boundary, got %s boundary", Name(tt.want), Name(node))
+			}
+		})
+	}
+}
+
 // prepareScopes sets up the scope analysis context for testing FindSafeScope.
 //
 // It finds the first variable usage.
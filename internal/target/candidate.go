@@ -17,11 +17,15 @@
 package target
 
 import (
+	"bytes"
+	"cmp"
 	"go/ast"
+	"go/printer"
 	"go/token"
 	"go/types"
 	"iter"
 	"slices"
+	"unicode/utf8"
 
 	"golang.org/x/tools/go/ast/inspector"
 
@@ -78,6 +82,27 @@ func (cm CandidateManager) BlockMovesWithTypeChanges(allUsages iter.Seq2[*types.
 	}
 }
 
+// BlockMovesWithDebugPrints marks candidates as blocked when the variable is used in an ignored debug print.
+//
+// Debug prints do not restrict the usage scope, so the move is only valid once they are removed.
+func (cm CandidateManager) BlockMovesWithDebugPrints(allUsages iter.Seq2[*types.Var, []usage.NodeUsage]) {
+	for _, usages := range allUsages {
+		for _, usage := range usages {
+			if !usage.Usage.DebugPrint() {
+				continue
+			}
+
+			m, ok := cm.candidates[usage.Decl]
+			if !ok || !m.movable() {
+				continue
+			}
+
+			m.status = check.MoveBlockedDebugPrint
+			cm.candidates[usage.Decl] = m
+		}
+	}
+}
+
 // BlockMovesLosingTypeInfo prevents moves that would lose necessary type information.
 //
 // Scenario: A variable is declared with an explicit or inferred type, then later reassigned
@@ -170,7 +195,9 @@ func (cm CandidateManager) typeChange(usages []usage.NodeUsage) bool {
 //
 // If conservative mode is on, all conflicts are blocked.
 // If not conservative, it attempts to combine compatible simple assignments (x:=1, y:=2 -> x,y:=1,2).
-func (cm CandidateManager) ResolveInitFieldConflicts(in *inspector.Inspector, combine bool) {
+// With a non-negative maxAbsorb, conflicts where more than maxAbsorb declarations would be absorbed are blocked.
+// With a non-negative maxWidth, conflicts where the combined initializer line would be wider are blocked.
+func (cm CandidateManager) ResolveInitFieldConflicts(fset *token.FileSet, in *inspector.Inspector, combine bool, maxAbsorb, maxWidth int) {
 	// Map to track multiple candidates for the same target node
 	targets := make(map[ast.Node][]astutil.NodeIndex)
 
@@ -188,13 +215,14 @@ func (cm CandidateManager) ResolveInitFieldConflicts(in *inspector.Inspector, co
 		targets[m.targetNode] = append(targets[m.targetNode], decl)
 	}
 
-	for _, decls := range targets {
+	for targetNode, decls := range targets {
 		if len(decls) < 2 {
 			continue
 		}
 
 		// Attempt to combine candidates
-		if combine && combinable(in, decls) {
+		if combine && (maxAbsorb < 0 || len(decls)-1 <= maxAbsorb) && combinable(in, decls) &&
+			(maxWidth < 0 || initWidth(fset, in, decls, targetNode) <= maxWidth) {
 			// If one candidate depends on another, they aren't movable.
 			cm.combine(decls)
 
@@ -222,6 +250,66 @@ func combinable(in *inspector.Inspector, decls []astutil.NodeIndex) bool {
 	return true
 }
 
+// initWidth projects the width of the target's first line with the declarations combined into its init field,
+// counting tabs as one column.
+func initWidth(fset *token.FileSet, in *inspector.Inspector, decls []astutil.NodeIndex, targetNode ast.Node) int {
+	var lhs, rhs []ast.Expr
+
+	for _, decl := range slices.Sorted(slices.Values(decls)) {
+		stmt := decl.Node(in).(*ast.AssignStmt)
+		lhs, rhs = append(lhs, stmt.Lhs...), append(rhs, stmt.Rhs...)
+	}
+
+	var buf bytes.Buffer
+
+	printList(&buf, fset, lhs)
+	buf.WriteString(" := ")
+	printList(&buf, fset, rhs)
+	buf.WriteString("; ")
+
+	decl, _, _ := bytes.Cut(buf.Bytes(), []byte("\n"))
+
+	// The existing header, from the keyword to the opening brace of the body
+	pos, body := fset.Position(targetNode.Pos()), fset.Position(targetBody(targetNode).Lbrace)
+
+	header := 0
+	if body.Line == pos.Line {
+		header = body.Column - pos.Column + 1
+	}
+
+	return pos.Column - 1 + header + utf8.RuneCount(decl)
+}
+
+// printList renders a comma-separated expression list.
+func printList(buf *bytes.Buffer, fset *token.FileSet, exprs []ast.Expr) {
+	for i, expr := range exprs {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+
+		_ = printer.Fprint(buf, fset, expr) // ignore error, an approximation suffices
+	}
+}
+
+// targetBody returns the body of an init field target.
+func targetBody(targetNode ast.Node) *ast.BlockStmt {
+	switch n := targetNode.(type) {
+	case *ast.IfStmt:
+		return n.Body
+
+	case *ast.ForStmt:
+		return n.Body
+
+	case *ast.SwitchStmt:
+		return n.Body
+
+	case *ast.TypeSwitchStmt:
+		return n.Body
+	}
+
+	return nil
+}
+
 // combine combines the declarations into the first one.
 func (cm CandidateManager) combine(decls []astutil.NodeIndex) {
 	// Sort by declaration index to ensure a deterministic order.
@@ -320,15 +408,88 @@ func (cm CandidateManager) OrphanedDeclarations(allUsages iter.Seq2[*types.Var,
 	return orphanedDeclarations
 }
 
+// UnusedCauses records why variables of declarations are unused:
+//   - Unused variables are reassigned by the next declaration or assignment before being read.
+//   - Otherwise, orphaned variables lose their reads when the first moved declaration of the variable is moved.
+func (cm CandidateManager) UnusedCauses(allUsages iter.Seq2[*types.Var, []usage.NodeUsage], unused, orphanedDeclarations map[astutil.NodeIndex][]*types.Var) map[astutil.NodeIndex][]UnusedCause {
+	causes := make(map[astutil.NodeIndex][]UnusedCause)
+
+	for v, usages := range allUsages {
+		moved := astutil.InvalidNode
+		for _, usage := range usages {
+			if m, ok := cm.candidates[usage.Decl]; ok && m.movable() {
+				moved = usage.Decl
+				break
+			}
+		}
+
+		for i, usage := range usages {
+			index := usage.Decl
+			if !index.Valid() {
+				continue
+			}
+
+			if slices.Contains(unused[index], v) {
+				if next, ok := nextDecl(usages[i+1:]); ok {
+					causes[index] = append(causes[index], UnusedCause{Name: v.Name(), Decl: next})
+					continue
+				}
+			}
+
+			if slices.Contains(orphanedDeclarations[index], v) && moved.Valid() {
+				causes[index] = append(causes[index], UnusedCause{Name: v.Name(), Decl: moved, Moved: true})
+			}
+		}
+	}
+
+	for _, c := range causes {
+		// Sort for deterministic output, causes are collected from map iteration
+		slices.SortFunc(c, func(a, b UnusedCause) int { return cmp.Compare(a.Name, b.Name) })
+	}
+
+	return causes
+}
+
+// AddressTaken returns the declarations of movable candidates with variables whose address is taken.
+func (cm CandidateManager) AddressTaken(allUsages iter.Seq2[*types.Var, []usage.NodeUsage]) map[astutil.NodeIndex]bool {
+	taken := make(map[astutil.NodeIndex]bool)
+
+	for _, usages := range allUsages {
+		for _, usage := range usages {
+			if !usage.Usage.AddressTaken() {
+				continue
+			}
+
+			if m, ok := cm.candidates[usage.Decl]; ok && m.movable() {
+				taken[usage.Decl] = true
+			}
+		}
+	}
+
+	return taken
+}
+
+// nextDecl returns the first valid declaration or assignment of the usages.
+func nextDecl(usages []usage.NodeUsage) (astutil.NodeIndex, bool) {
+	for _, usage := range usages {
+		if usage.Decl.Valid() {
+			return usage.Decl, true
+		}
+	}
+
+	return astutil.InvalidNode, false
+}
+
 // SortedMoveTargets converts the intermediate candidate map to a sorted slice of MoveTarget.
 //
 // Combines:
 //   - Regular move candidates (with or without unused variables)
 //   - Orphaned declarations (no target node, all variables unused)
+//   - Silenced declarations (no target node, removed with their blank assignments)
 //
 // Returns results sorted by source position for deterministic output.
-func (cm CandidateManager) SortedMoveTargets(unused, orphanedDeclarations map[astutil.NodeIndex][]*types.Var) []MoveTarget {
-	moveTargets := make([]MoveTarget, 0, len(cm.candidates)+len(orphanedDeclarations))
+func (cm CandidateManager) SortedMoveTargets(unused, orphanedDeclarations map[astutil.NodeIndex][]*types.Var, silenced []MoveTarget) []MoveTarget {
+	moveTargets := make([]MoveTarget, 0, len(cm.candidates)+len(orphanedDeclarations)+len(silenced))
 
 	for decl, m := range cm.candidates {
 		var absorbedDecls []MovableDecl
@@ -343,6 +504,8 @@ func (cm CandidateManager) SortedMoveTargets(unused, orphanedDeclarations map[as
 		moveTargets = append(moveTargets, MoveTarget{MovableDecl: MovableDecl{Decl: decl, Unused: varNames(orphaned)}, TargetNode: nil, AbsorbedDecls: nil, Status: check.MoveAllowed})
 	}
 
+	moveTargets = append(moveTargets, silenced...)
+
 	// Sort targets in traversal order.
 	slices.SortFunc(moveTargets, func(a, b MoveTarget) int { return int(a.Decl - b.Decl) })
 
@@ -354,6 +517,9 @@ func varNames(vars []*types.Var) []string {
 		return nil
 	}
 
+	// Report in declaration order, vars are collected from map iteration
+	vars = slices.SortedFunc(slices.Values(vars), func(a, b *types.Var) int { return cmp.Compare(a.Pos(), b.Pos()) })
+
 	names := make([]string, len(vars))
 	for i, v := range vars {
 		names[i] = v.Name()
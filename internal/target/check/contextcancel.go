@@ -0,0 +1,57 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package check
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// ContextCancel reports whether the declaration is the ctx, cancel := context.WithX(...) idiom.
+//
+// The cancel function bounds the lifetime of the derived context and is typically deferred right after
+// the declaration, so such declarations are never proposed for tightening, even if they could be moved.
+func ContextCancel(info *types.Info, decl ast.Node) bool {
+	stmt, ok := decl.(*ast.AssignStmt)
+	if !ok || len(stmt.Lhs) != 2 || len(stmt.Rhs) != 1 {
+		return false
+	}
+
+	if id, ok := ast.Unparen(stmt.Lhs[1]).(*ast.Ident); !ok || id.Name == "_" {
+		return false // The cancel function is discarded
+	}
+
+	call, ok := ast.Unparen(stmt.Rhs[0]).(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+
+	fn, ok := typeutil.Callee(info, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != "context" {
+		return false
+	}
+
+	switch fn.Name() {
+	case "WithCancel", "WithCancelCause", "WithDeadline", "WithDeadlineCause", "WithTimeout", "WithTimeoutCause":
+		return true
+
+	default:
+		return false
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package check
+
+import (
+	"go/ast"
+	"go/types"
+)
+
+// errorInterface is the predeclared "error" interface.
+var errorInterface = types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+
+// ErrorVar reports whether the declaration assigns a variable named err or of a type implementing error.
+func ErrorVar(info *types.Info, decl ast.Node) bool {
+	found := false
+
+	ast.Inspect(decl, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+
+		case *ast.AssignStmt:
+			for _, expr := range n.Lhs {
+				found = found || errorIdent(info, expr)
+			}
+
+			return false
+
+		case *ast.ValueSpec:
+			for _, id := range n.Names {
+				found = found || errorIdent(info, id)
+			}
+
+			return false
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// errorIdent reports whether the expression is a variable named err or of a type implementing error.
+func errorIdent(info *types.Info, expr ast.Expr) bool {
+	id, ok := expr.(*ast.Ident)
+	if !ok || id.Name == "_" {
+		return false
+	}
+
+	v, ok := info.ObjectOf(id).(*types.Var)
+	if !ok {
+		return false
+	}
+
+	return v.Name() == "err" || types.Implements(v.Type(), errorInterface)
+}
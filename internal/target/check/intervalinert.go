@@ -39,10 +39,14 @@ import (
 //
 // Pure declarations (var, const, type) and short variable declarations of *new*
 // variables initialized with constant expressions and no function calls are
-// considered inert.
+// considered inert. Calls of the built-in min and max functions are inert when
+// their arguments are, or read variables declared by inert statements of the interval.
 //
 // The check covers the interval [start, end), excluding the end position.
 func IntervalInert(info *types.Info, parent inspector.Cursor, absorbedDecls []astutil.NodeIndex, start, end token.Pos) bool {
+	// Variables declared by inert statements of the interval, which the moved code can't modify
+	declared := make(map[types.Object]struct{})
+
 	// Iterate over all nodes in the parent to find statements in the interval.
 	for s := range parent.Preorder(
 		// keep-sorted start
@@ -87,12 +91,28 @@ func IntervalInert(info *types.Info, parent inspector.Cursor, absorbedDecls []as
 
 		switch stmt := n.(type) {
 		case *ast.AssignStmt:
-			if inertShortDecl(info, stmt) {
+			if inertShortDecl(info, stmt, declared) {
+				for _, id := range stmt.Lhs {
+					if obj := info.Defs[id.(*ast.Ident)]; obj != nil {
+						declared[obj] = struct{}{}
+					}
+				}
+
 				continue // Safe declaration
 			}
 
 		case *ast.GenDecl:
-			if inertVarDecl(info, stmt) {
+			if inertVarDecl(info, stmt, declared) {
+				for _, spec := range stmt.Specs {
+					if spec, ok := spec.(*ast.ValueSpec); ok && stmt.Tok == token.VAR {
+						for _, id := range spec.Names {
+							if obj := info.Defs[id]; obj != nil {
+								declared[obj] = struct{}{}
+							}
+						}
+					}
+				}
+
 				continue // Safe declaration
 			}
 		}
@@ -103,14 +123,19 @@ func IntervalInert(info *types.Info, parent inspector.Cursor, absorbedDecls []as
 	return true
 }
 
-// inertShortDecl analyzes an assignment statement to determine if it declares a
+// InertShortDecl analyzes an assignment statement to determine if it declares a
 // constant expression without side effects.
 //
 // It ensures that:
 // 1. It is a short variable declaration (:=).
 // 2. All identifiers on the LHS are *new* definitions (no reassignments).
 // 3. All expressions on the RHS are inert (constants or safe built-ins).
-func inertShortDecl(info *types.Info, stmt *ast.AssignStmt) bool {
+func InertShortDecl(info *types.Info, stmt *ast.AssignStmt) bool {
+	return inertShortDecl(info, stmt, nil)
+}
+
+// inertShortDecl is [InertShortDecl], permitting min and max arguments reading declared variables.
+func inertShortDecl(info *types.Info, stmt *ast.AssignStmt, declared map[types.Object]struct{}) bool {
 	if stmt.Tok != token.DEFINE {
 		return false
 	}
@@ -134,7 +159,7 @@ func inertShortDecl(info *types.Info, stmt *ast.AssignStmt) bool {
 	}
 
 	for _, expr := range stmt.Rhs {
-		if !inertExpr(info, expr) {
+		if !inertExpr(info, expr, declared) {
 			return false
 		}
 	}
@@ -143,7 +168,7 @@ func inertShortDecl(info *types.Info, stmt *ast.AssignStmt) bool {
 }
 
 // inertVarDecl checks if a GenDecl AST node represents a `var` declaration that includes initialization values.
-func inertVarDecl(info *types.Info, stmt *ast.GenDecl) bool {
+func inertVarDecl(info *types.Info, stmt *ast.GenDecl, declared map[types.Object]struct{}) bool {
 	if stmt.Tok != token.VAR { // type declaration and const are safe
 		return true
 	}
@@ -153,7 +178,7 @@ func inertVarDecl(info *types.Info, stmt *ast.GenDecl) bool {
 		if spec, ok := spec.(*ast.ValueSpec); ok {
 			for _, expr := range spec.Values {
 				// Check for constant
-				if !inertExpr(info, expr) {
+				if !inertExpr(info, expr, declared) {
 					return false
 				}
 			}
@@ -164,14 +189,30 @@ func inertVarDecl(info *types.Info, stmt *ast.GenDecl) bool {
 }
 
 // inertExpr determines if an expression has no side effects, such as being a constant or involving `new` with constant arguments.
-func inertExpr(info *types.Info, expr ast.Expr) bool {
+//
+// Arguments of min and max may additionally be inert expressions or read declared variables.
+func inertExpr(info *types.Info, expr ast.Expr, declared map[types.Object]struct{}) bool {
 	if tv, ok := info.Types[expr]; ok && tv.Value != nil {
 		return true
 	}
 
 	// Check for new(...)
 	call, ok := ast.Unparen(expr).(*ast.CallExpr)
-	if !ok || !builtin(info, call.Fun) {
+	if !ok {
+		return false
+	}
+
+	if pureBuiltin(info, call.Fun) {
+		for _, arg := range call.Args {
+			if !inertExpr(info, arg, declared) && !declaredVar(info, arg, declared) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	if !builtin(info, call.Fun) {
 		return false
 	}
 
@@ -185,6 +226,61 @@ func inertExpr(info *types.Info, expr ast.Expr) bool {
 	return true
 }
 
+// HasCall reports whether the expressions contain a function call, excluding conversions and built-in functions.
+// Function literal bodies are not considered, since they are not executed by the expression.
+func HasCall(info *types.Info, exprs []ast.Expr) bool {
+	found := false
+
+	for _, expr := range exprs {
+		ast.Inspect(expr, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.FuncLit:
+				return false
+
+			case *ast.CallExpr:
+				if tv, ok := info.Types[n.Fun]; ok && (tv.IsType() || tv.IsBuiltin()) {
+					break
+				}
+
+				found = true
+			}
+
+			return !found
+		})
+
+		if found {
+			break
+		}
+	}
+
+	return found
+}
+
+// pureBuiltin checks if the call expression is a call to the built-in `min` or `max` function,
+// which have no side effects. The built-in `clear` modifies its argument and is excluded.
+func pureBuiltin(info *types.Info, fun ast.Expr) bool {
+	id, ok := ast.Unparen(fun).(*ast.Ident)
+	if !ok || id.Name != "min" && id.Name != "max" {
+		return false
+	}
+
+	_, ok = info.Uses[id].(*types.Builtin)
+
+	return ok
+}
+
+// declaredVar checks if the expression reads one of the declared variables.
+func declaredVar(info *types.Info, expr ast.Expr, declared map[types.Object]struct{}) bool {
+	id, ok := ast.Unparen(expr).(*ast.Ident)
+	if !ok {
+		return false
+	}
+
+	_, ok = declared[info.Uses[id]]
+
+	return ok
+}
+
 // builtin checks if the call expression is a call to the built-in `new` function.
 func builtin(info *types.Info, fun ast.Expr) bool {
 	id, ok := ast.Unparen(fun).(*ast.Ident)
@@ -109,6 +109,43 @@ func TestIntervalInert(t *testing.T) {
 			want:     true,
 		},
 
+		// Built-in min and max
+		{
+			name:     "max_with_declared_vars",
+			src:      `lo := 1; hi := 2; m := max(lo, hi); _ = m`,
+			interval: func(b *ast.BlockStmt) (start, end token.Pos) { return b.Lbrace, b.List[2].End() },
+			want:     true,
+			version:  "go1.21",
+		},
+		{
+			name:     "min_with_nested_max",
+			src:      `lo := 1; m := min(max(lo, 0), 10); _ = m`,
+			interval: func(b *ast.BlockStmt) (start, end token.Pos) { return b.Lbrace, b.List[1].End() },
+			want:     true,
+			version:  "go1.21",
+		},
+		{
+			name:     "max_with_outer_var",
+			src:      `lo := 1; m := max(lo, 2); _ = m`,
+			interval: func(b *ast.BlockStmt) (start, end token.Pos) { return b.List[0].End(), b.List[1].End() },
+			want:     false,
+			version:  "go1.21",
+		},
+		{
+			name:     "max_with_call",
+			src:      `m := max(len(make([]int, 1)), 2); _ = m`,
+			interval: func(b *ast.BlockStmt) (start, end token.Pos) { return b.Lbrace, b.List[0].End() },
+			want:     false,
+			version:  "go1.21",
+		},
+		{
+			name:     "clear_statement",
+			src:      `s := make([]int, 1); clear(s)`,
+			interval: func(b *ast.BlockStmt) (start, end token.Pos) { return b.Lbrace, b.List[1].End() },
+			want:     false,
+			version:  "go1.21",
+		},
+
 		// Control flow statements
 		{
 			name:     "if_statement",
@@ -0,0 +1,135 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package check
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"slices"
+
+	"golang.org/x/tools/go/ast/edge"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// LoopInvariant reports whether the declaration can be moved into a loop body
+// without changing semantics.
+//
+// This requires every iteration to see a fresh variable with the same value:
+//   - All initial values must be constants or nil, so re-evaluating them yields the same result
+//   - The declared variables must only be read, so no iteration depends on a value written by a previous one
+func LoopInvariant(info *types.Info, decl inspector.Cursor) bool {
+	var vars []*types.Var
+
+	switch n := decl.Node().(type) {
+	case *ast.AssignStmt:
+		if n.Tok != token.DEFINE || !constantValues(info, n.Rhs) {
+			return false
+		}
+
+		for _, e := range n.Lhs {
+			id, ok := e.(*ast.Ident)
+			if !ok {
+				return false
+			}
+
+			if id.Name == "_" {
+				continue
+			}
+
+			v, ok := info.Defs[id].(*types.Var)
+			if !ok {
+				return false // Redeclaration of an existing variable
+			}
+
+			vars = append(vars, v)
+		}
+
+	case *ast.DeclStmt:
+		gen, ok := n.Decl.(*ast.GenDecl)
+		if !ok {
+			return false
+		}
+
+		for _, spec := range gen.Specs {
+			vspec, ok := spec.(*ast.ValueSpec)
+			if !ok || !constantValues(info, vspec.Values) {
+				return false
+			}
+
+			for _, id := range vspec.Names {
+				if v, ok := info.Defs[id].(*types.Var); ok {
+					vars = append(vars, v)
+				}
+			}
+		}
+
+	default:
+		return false
+	}
+
+	// All uses are in the scope of the declaration, which is part of the enclosing statement list.
+	return onlyRead(info, decl.Parent(), vars)
+}
+
+// constantValues checks whether all expressions are constants or nil.
+func constantValues(info *types.Info, exprs []ast.Expr) bool {
+	for _, e := range exprs {
+		tv, ok := info.Types[e]
+		if !ok || tv.Value == nil && !tv.IsNil() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// onlyRead checks whether all uses of the variables in root are reads that can't modify them.
+func onlyRead(info *types.Info, root inspector.Cursor, vars []*types.Var) bool {
+	if len(vars) == 0 {
+		return true
+	}
+
+	for c := range root.Preorder((*ast.Ident)(nil)) {
+		v, ok := info.Uses[c.Node().(*ast.Ident)].(*types.Var)
+		if !ok || !slices.Contains(vars, v) {
+			continue
+		}
+
+		// Skip enclosing parentheses
+		for kind, _ := c.ParentEdge(); kind == edge.ParenExpr_X; kind, _ = c.ParentEdge() {
+			c = c.Parent()
+		}
+
+		switch kind, _ := c.ParentEdge(); kind {
+		case edge.AssignStmt_Lhs, // Reassignment
+			edge.IncDecStmt_X,  // Increment or decrement
+			edge.RangeStmt_Key, // Assigned by range
+			edge.RangeStmt_Value,
+			edge.SelectorExpr_X, // Field assignment or pointer method call
+			edge.IndexExpr_X:    // Array element assignment
+			return false
+
+		case edge.UnaryExpr_X:
+			if c.Parent().Node().(*ast.UnaryExpr).Op == token.AND {
+				return false // Address taken
+			}
+		}
+	}
+
+	return true
+}
@@ -58,7 +58,50 @@ const (
 	// MoveBlockedStatements indicates the move is blocked because of intervening statements.
 	// This only applies in conservative mode, where any potential side effect blocks a move.
 	MoveBlockedStatements // xst
+
+	// MoveBlockedDebugPrint indicates the move is blocked because the variable is printed for debugging.
+	// The move is only valid after the debug prints are removed, so no fix is generated.
+	MoveBlockedDebugPrint // dbg
+
+	// MoveBlockedDocumented indicates the move is blocked because the var declaration has a doc comment.
+	// Documented declarations are often intentionally prominent, so no fix is generated.
+	MoveBlockedDocumented // doc
 )
 
 // Movable indicates the declaration could be moved.
 func (i MoveStatus) Movable() bool { return i == MoveAllowed }
+
+// Reason describes why the move is blocked, or returns the empty string if it isn't.
+func (i MoveStatus) Reason() string {
+	switch i {
+	case MoveBlockedInitConflict:
+		return "another declaration targets the same init field"
+
+	case MoveBlockedTypeIncompatible:
+		return "subsequent code would infer a different type"
+
+	case MoveBlockedGenerated:
+		return "the file is generated"
+
+	case MoveBlockedDeclared:
+		return "the name is already declared in the target scope"
+
+	case MoveBlockedShadowed:
+		return "an identifier used by the declaration is shadowed in the target scope"
+
+	case MoveBlockedTypeChange:
+		return "the type of a variable would change"
+
+	case MoveBlockedStatements:
+		return "intervening statements may have side effects"
+
+	case MoveBlockedDebugPrint:
+		return "the variable is printed for debugging"
+
+	case MoveBlockedDocumented:
+		return "the declaration has a doc comment"
+
+	default:
+		return ""
+	}
+}
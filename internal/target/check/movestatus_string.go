@@ -17,11 +17,13 @@ func _() {
 	_ = x[MoveBlockedShadowed-6]
 	_ = x[MoveBlockedTypeChange-7]
 	_ = x[MoveBlockedStatements-8]
+	_ = x[MoveBlockedDebugPrint-9]
+	_ = x[MoveBlockedDocumented-10]
 }
 
-const _MoveStatus_name = "moviniabstypgendecshwtchxst"
+const _MoveStatus_name = "moviniabstypgendecshwtchxstdbgdoc"
 
-var _MoveStatus_index = [...]uint8{0, 3, 6, 9, 12, 15, 18, 21, 24, 27}
+var _MoveStatus_index = [...]uint8{0, 3, 6, 9, 12, 15, 18, 21, 24, 27, 30, 33}
 
 func (i MoveStatus) String() string {
 	idx := int(i) - 0
@@ -0,0 +1,34 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package check_test
+
+import (
+	"testing"
+
+	. "fillmore-labs.com/scopeguard/internal/target/check"
+)
+
+func TestMoveStatusReason(t *testing.T) {
+	t.Parallel()
+
+	for status := MoveAllowed; status <= MoveBlockedDocumented; status++ {
+		informational := status == MoveAllowed || status == MoveAbsorbed
+		if got := status.Reason(); (got == "") != informational {
+			t.Errorf("Reason of %s is %q", status, got)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package check
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// RecursiveClosure reports whether a variable of the var declaration is later assigned a function literal
+// referencing the variable itself or another variable of the declaration:
+//
+//	var f func(int) int
+//	f = func(n int) int { return f(n - 1) }
+//
+// The function literal can't be part of a short variable declaration, since the variable is not in scope
+// of its own initializer, so such declarations are left alone.
+func RecursiveClosure(info *types.Info, decl inspector.Cursor) bool {
+	stmt, ok := decl.Node().(*ast.DeclStmt)
+	if !ok {
+		return false
+	}
+
+	vars := make(map[types.Object]struct{})
+
+	for c := range decl.Preorder((*ast.Ident)(nil)) {
+		if v, ok := info.Defs[c.Node().(*ast.Ident)].(*types.Var); ok {
+			vars[v] = struct{}{}
+		}
+	}
+
+	if len(vars) == 0 {
+		return false
+	}
+
+	for c := range decl.Parent().Preorder((*ast.AssignStmt)(nil)) {
+		asgn := c.Node().(*ast.AssignStmt)
+		if asgn.Pos() < stmt.End() || asgn.Tok != token.ASSIGN || len(asgn.Lhs) != len(asgn.Rhs) {
+			continue
+		}
+
+		for i, lhs := range asgn.Lhs {
+			id, ok := ast.Unparen(lhs).(*ast.Ident)
+			if !ok {
+				continue
+			}
+
+			if _, ok := vars[info.Uses[id]]; !ok {
+				continue
+			}
+
+			if lit, ok := ast.Unparen(asgn.Rhs[i]).(*ast.FuncLit); ok && references(info, lit.Body, vars) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// references reports whether any of the objects is used in the node.
+func references(info *types.Info, n ast.Node, objs map[types.Object]struct{}) bool {
+	found := false
+
+	ast.Inspect(n, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok {
+			_, found = objs[info.Uses[id]]
+		}
+
+		return !found
+	})
+
+	return found
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package check
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// SubtestSetup reports whether variables of the declaration are read by a subtest,
+// i.e. a function literal passed to (*testing.T).Run or (*testing.B).Run.
+//
+// Setup shared by table-driven subtests is intentionally declared before the loop running them.
+func SubtestSetup(info *types.Info, decl inspector.Cursor) bool {
+	vars := make(map[types.Object]struct{})
+
+	for c := range decl.Preorder((*ast.Ident)(nil)) {
+		if v, ok := info.Defs[c.Node().(*ast.Ident)].(*types.Var); ok {
+			vars[v] = struct{}{}
+		}
+	}
+
+	if len(vars) == 0 {
+		return false
+	}
+
+	end := decl.Node().End()
+
+	for c := range decl.Parent().Preorder((*ast.CallExpr)(nil)) {
+		call := c.Node().(*ast.CallExpr)
+		if call.Pos() < end || len(call.Args) == 0 || !subtestRun(info, call) {
+			continue
+		}
+
+		lit, ok := ast.Unparen(call.Args[len(call.Args)-1]).(*ast.FuncLit)
+		if !ok {
+			continue
+		}
+
+		found := false
+
+		ast.Inspect(lit.Body, func(n ast.Node) bool {
+			if id, ok := n.(*ast.Ident); ok {
+				_, found = vars[info.Uses[id]]
+			}
+
+			return !found
+		})
+
+		if found {
+			return true
+		}
+	}
+
+	return false
+}
+
+// subtestRun reports whether the call is (*testing.T).Run or (*testing.B).Run.
+func subtestRun(info *types.Info, call *ast.CallExpr) bool {
+	fn, ok := typeutil.Callee(info, call).(*types.Func)
+	if !ok || fn.Name() != "Run" {
+		return false
+	}
+
+	recv := fn.Signature().Recv()
+	if recv == nil {
+		return false
+	}
+
+	ptr, ok := recv.Type().(*types.Pointer)
+	if !ok {
+		return false
+	}
+
+	named, ok := ptr.Elem().(*types.Named)
+	if !ok {
+		return false
+	}
+
+	obj := named.Obj()
+
+	return obj.Pkg() != nil && obj.Pkg().Path() == "testing" && (obj.Name() == "T" || obj.Name() == "B")
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package check
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"iter"
+	"slices"
+)
+
+// UnreachableTarget reports whether the target block starts with an unconditional terminator - return, break,
+// continue or a call to panic - not using any of the identifiers.
+//
+// All uses in such a block are unreachable, so moving the declaration there is pointless.
+func UnreachableTarget(info *types.Info, target ast.Node, identifiers iter.Seq[string]) bool {
+	var list []ast.Stmt
+
+	switch n := target.(type) {
+	case *ast.BlockStmt:
+		list = n.List
+
+	case *ast.CaseClause:
+		list = n.Body
+
+	case *ast.CommClause:
+		list = n.Body
+
+	default:
+		return false
+	}
+
+	if len(list) == 0 || !terminator(info, list[0]) {
+		return false
+	}
+
+	names := slices.Collect(identifiers)
+
+	for n := range ast.Preorder(list[0]) {
+		id, ok := n.(*ast.Ident)
+		if !ok || !slices.Contains(names, id.Name) {
+			continue
+		}
+
+		if obj := info.Uses[id]; obj != nil && obj.Pos() < list[0].Pos() {
+			return false // The terminator uses the declaration
+		}
+	}
+
+	return true
+}
+
+// terminator reports whether the statement unconditionally leaves the block.
+func terminator(info *types.Info, stmt ast.Stmt) bool {
+	switch n := stmt.(type) {
+	case *ast.ReturnStmt:
+		return true
+
+	case *ast.BranchStmt:
+		return n.Tok == token.BREAK || n.Tok == token.CONTINUE
+
+	case *ast.ExprStmt:
+		call, ok := ast.Unparen(n.X).(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+
+		id, ok := ast.Unparen(call.Fun).(*ast.Ident)
+		if !ok {
+			return false
+		}
+
+		b, ok := info.Uses[id].(*types.Builtin)
+
+		return ok && b.Name() == "panic"
+
+	default:
+		return false
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package target
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/ast/edge"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"fillmore-labs.com/scopeguard/internal/astutil"
+	"fillmore-labs.com/scopeguard/internal/target/check"
+)
+
+// CondInline represents an if statement initializer whose variable is only used once in the condition.
+type CondInline struct {
+	If  astutil.NodeIndex // The if statement
+	Use *ast.Ident        // The use of the variable in the condition
+	Var *types.Var        // The declared variable
+}
+
+// CondInlines finds if statement initializers declaring a single variable only used once in the condition:
+//
+//	if n := len(s); n > 0 {
+//
+// can be written as if len(s) > 0. Initializers with calls are not considered when the use is only conditionally
+// evaluated as the right operand of && or ||, since inlining would skip the call.
+func (ts Stage) CondInlines(body inspector.Cursor) []CondInline {
+	var inlines []CondInline
+
+	for c := range body.Preorder((*ast.IfStmt)(nil)) {
+		if inline, ok := ts.condInline(c); ok {
+			inlines = append(inlines, inline)
+		}
+	}
+
+	return inlines
+}
+
+// condInline checks whether the initializer of an if statement declares a variable only used once in the condition.
+func (ts Stage) condInline(c inspector.Cursor) (CondInline, bool) {
+	n := c.Node().(*ast.IfStmt)
+
+	asgn, ok := n.Init.(*ast.AssignStmt)
+	if !ok || asgn.Tok != token.DEFINE || len(asgn.Lhs) != 1 || len(asgn.Rhs) != 1 {
+		return CondInline{}, false
+	}
+
+	id, ok := asgn.Lhs[0].(*ast.Ident)
+	if !ok {
+		return CondInline{}, false
+	}
+
+	v, ok := ts.TypesInfo.Defs[id].(*types.Var)
+	if !ok || ts.constantNonInt(asgn.Rhs[0], v) {
+		return CondInline{}, false
+	}
+
+	var (
+		use  inspector.Cursor
+		uses int
+	)
+
+	for i := range c.Preorder((*ast.Ident)(nil)) {
+		if ts.TypesInfo.Uses[i.Node().(*ast.Ident)] != v {
+			continue
+		}
+
+		if uses++; uses > 1 {
+			return CondInline{}, false
+		}
+
+		use = i
+	}
+
+	if uses != 1 || !inCond(use, n.Cond) {
+		return CondInline{}, false
+	}
+
+	if check.HasCall(ts.TypesInfo, asgn.Rhs) && shortCircuited(use) {
+		return CondInline{}, false
+	}
+
+	return CondInline{If: astutil.NodeIndexOf(c), Use: use.Node().(*ast.Ident), Var: v}, true
+}
+
+// inCond reports whether the identifier is part of the condition, outside of function literals.
+func inCond(id inspector.Cursor, cond ast.Expr) bool {
+	if pos := id.Node().Pos(); pos < cond.Pos() || cond.End() <= pos {
+		return false
+	}
+
+	for c := id; c.Node() != cond; c = c.Parent() {
+		if kind, _ := c.ParentEdge(); kind == edge.FuncLit_Body {
+			return false
+		}
+	}
+
+	return true
+}
+
+// shortCircuited reports whether the identifier is part of the right operand of && or ||.
+func shortCircuited(id inspector.Cursor) bool {
+	for c := id; ; c = c.Parent() {
+		switch kind, _ := c.ParentEdge(); kind {
+		case edge.BinaryExpr_Y:
+			if b, ok := c.Parent().Node().(*ast.BinaryExpr); ok && (b.Op == token.LAND || b.Op == token.LOR) {
+				return true
+			}
+
+		case edge.Invalid, edge.IfStmt_Cond:
+			return false
+		}
+	}
+}
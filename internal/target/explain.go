@@ -0,0 +1,83 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package target
+
+import (
+	"context"
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/ast/inspector"
+
+	"fillmore-labs.com/scopeguard/internal/astutil"
+	"fillmore-labs.com/scopeguard/internal/usage"
+)
+
+// Decision records the intermediate results of analyzing a single declaration.
+type Decision struct {
+	// DeclScope is the scope of the declaration, UsageScope the tightest scope containing all uses
+	// and SafeScope the tightest scope the declaration can be moved to.
+	DeclScope, UsageScope, SafeScope *types.Scope
+
+	// TargetNode is the node with the target scope, or nil if there is none.
+	TargetNode ast.Node
+
+	// Status is the final status of the reported move, or nil if the declaration is not reported.
+	Status MoveStatus
+
+	// Reason explains why the declaration is not a move candidate, if it isn't.
+	Reason string
+}
+
+// Explain runs target selection for a function body and records the decisions made for a single declaration.
+func (ts Stage) Explain(ctx context.Context, cf astutil.CurrentFile, body inspector.Cursor, usageData usage.Result, decl astutil.NodeIndex) Decision {
+	var d Decision
+
+	scopeRange, ok := usageData.ScopeRange(decl)
+	if !ok {
+		d.Reason = "declaration not tracked"
+
+		return d
+	}
+
+	d.DeclScope, d.UsageScope = scopeRange.Decl, scopeRange.Usage
+
+	if d.UsageScope == d.DeclScope {
+		d.Reason = "already at the innermost scope"
+	} else {
+		in := body.Inspector()
+		d.SafeScope, _ = ts.safeScope(cf, decl.Cursor(in), d.DeclScope, d.UsageScope)
+
+		var m MoveCandidate
+		m, d.Reason = ts.analyzeCandidate(in, cf, decl, d.DeclScope, d.UsageScope, sortedLabels(ts.TypesInfo, body))
+		d.TargetNode = m.targetNode
+	}
+
+	// Absorbed declarations should be visible with their own status
+	ts.GroupRelated = false
+
+	// Orphaned declarations are reported even when they are not move candidates
+	for _, move := range ts.SelectTargets(ctx, cf, body, usageData) {
+		if move.Decl == decl {
+			d.TargetNode, d.Status = move.TargetNode, move.Status
+
+			break
+		}
+	}
+
+	return d
+}
@@ -0,0 +1,156 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package target
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/ast/edge"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"fillmore-labs.com/scopeguard/internal/astutil"
+	"fillmore-labs.com/scopeguard/internal/target/check"
+)
+
+// InlineRange represents a short variable declaration only used as the range expression of the following loop.
+type InlineRange struct {
+	Decl  astutil.NodeIndex // The short variable declaration
+	Range astutil.NodeIndex // The range statement using the variable
+	Var   *types.Var        // The declared variable
+}
+
+// InlineRanges finds short variable declarations whose variable is only used as the range expression
+// of the loop directly following the declaration:
+//
+//	keys := maps.Keys(m)
+//	for k := range keys {
+//
+// can be written as for k := range maps.Keys(m). Only inert initializers and simple calls, whose arguments
+// contain no further calls, are considered. Since the range expression is evaluated once before the loop,
+// inlining does not change the evaluation order.
+func (ts Stage) InlineRanges(body inspector.Cursor) []InlineRange {
+	var inlines []InlineRange
+
+	for list := range body.Preorder((*ast.BlockStmt)(nil), (*ast.CaseClause)(nil), (*ast.CommClause)(nil)) {
+		for stmt := range stmtList(list) {
+			if inline, ok := ts.inlineRange(stmt); ok {
+				inlines = append(inlines, inline)
+			}
+		}
+	}
+
+	return inlines
+}
+
+// inlineRange checks whether stmt declares a variable only used as the range expression of the next statement.
+func (ts Stage) inlineRange(stmt inspector.Cursor) (InlineRange, bool) {
+	asgn, ok := stmt.Node().(*ast.AssignStmt)
+	if !ok || len(asgn.Lhs) != 1 || len(asgn.Rhs) != 1 {
+		return InlineRange{}, false
+	}
+
+	id, ok := asgn.Lhs[0].(*ast.Ident)
+	if !ok {
+		return InlineRange{}, false
+	}
+
+	v, ok := ts.TypesInfo.Defs[id].(*types.Var)
+	if !ok || !check.InertShortDecl(ts.TypesInfo, asgn) && !ts.simpleCall(asgn.Rhs[0]) || ts.constantNonInt(asgn.Rhs[0], v) {
+		return InlineRange{}, false
+	}
+
+	next, ok := stmt.NextSibling()
+	if !ok {
+		return InlineRange{}, false
+	}
+
+	loop := next
+	for {
+		if _, ok := loop.Node().(*ast.LabeledStmt); !ok {
+			break
+		}
+
+		loop = loop.ChildAt(edge.LabeledStmt_Stmt, -1)
+	}
+
+	rng, ok := loop.Node().(*ast.RangeStmt)
+	if !ok {
+		return InlineRange{}, false
+	}
+
+	if x, ok := ast.Unparen(rng.X).(*ast.Ident); !ok || ts.TypesInfo.Uses[x] != v {
+		return InlineRange{}, false
+	}
+
+	// The range expression must be the only use
+	uses := 0
+
+	for s, ok := next, true; ok; s, ok = s.NextSibling() {
+		for i := range s.Preorder((*ast.Ident)(nil)) {
+			if ts.TypesInfo.Uses[i.Node().(*ast.Ident)] == v {
+				uses++
+			}
+		}
+	}
+
+	if uses != 1 {
+		return InlineRange{}, false
+	}
+
+	return InlineRange{Decl: astutil.NodeIndexOf(stmt), Range: astutil.NodeIndexOf(loop), Var: v}, true
+}
+
+// simpleCall reports whether expr is a function or method call, possibly chained, whose arguments contain no calls.
+func (ts Stage) simpleCall(expr ast.Expr) bool {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok || check.HasCall(ts.TypesInfo, call.Args) {
+		return false
+	}
+
+	if tv, ok := ts.TypesInfo.Types[call.Fun]; ok && (tv.IsType() || tv.IsBuiltin()) {
+		return false // Conversions and built-ins are not calls to inline
+	}
+
+	switch fun := ast.Unparen(call.Fun).(type) {
+	case *ast.Ident:
+		return true
+
+	case *ast.SelectorExpr:
+		if _, ok := ast.Unparen(fun.X).(*ast.CallExpr); ok {
+			return ts.simpleCall(fun.X) // Method chain
+		}
+
+		return !check.HasCall(ts.TypesInfo, []ast.Expr{fun.X})
+
+	default:
+		return false
+	}
+}
+
+// constantNonInt reports whether expr is an integer constant assigned to a variable not of type int.
+// Inlined untyped constants like 'a' would range over int instead.
+func (ts Stage) constantNonInt(expr ast.Expr, v *types.Var) bool {
+	tv, ok := ts.TypesInfo.Types[expr]
+	if !ok || tv.Value == nil {
+		return false
+	}
+
+	basic, ok := v.Type().(*types.Basic)
+
+	return ok && basic.Info()&types.IsInteger != 0 && basic.Kind() != types.Int
+}
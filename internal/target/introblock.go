@@ -0,0 +1,207 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package target
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"iter"
+	"slices"
+
+	"golang.org/x/tools/go/ast/inspector"
+
+	"fillmore-labs.com/scopeguard/internal/astutil"
+	"fillmore-labs.com/scopeguard/internal/usage"
+)
+
+// IntroBlock represents a run of statements from a declaration to the last use of its variables,
+// which can be wrapped in a new block to narrow their scope.
+type IntroBlock struct {
+	Decl astutil.NodeIndex // The declaration starting the run
+	Last astutil.NodeIndex // The last statement of the run
+	Vars []*types.Var      // The variables scoped by the new block
+}
+
+// IntroBlocks finds declarations staying in their scope whose variables are only used by the statements
+// directly following them, while further statements of the same list remain:
+//
+//	buf := make([]byte, 64)
+//	n := read(buf)
+//	process(buf[:n])
+//	finish()
+//
+// can be written with buf and n scoped by a new block ending before finish(). Runs are only reported when
+// no declaration in them is used after the run and no statement in them is labeled. Runs sharing their
+// last statement are reported once, starting at the first declaration.
+func (ts Stage) IntroBlocks(body inspector.Cursor, scopeRanges iter.Seq2[astutil.NodeIndex, usage.ScopeRange]) []IntroBlock {
+	staying := make(map[astutil.NodeIndex]struct{})
+
+	for decl, scopeRange := range scopeRanges {
+		if decl.Valid() && scopeRange.Usage == scopeRange.Decl {
+			staying[decl] = struct{}{}
+		}
+	}
+
+	var blocks []IntroBlock
+
+	for list := range body.Preorder((*ast.BlockStmt)(nil), (*ast.CaseClause)(nil), (*ast.CommClause)(nil)) {
+		stmts := slices.Collect(stmtList(list))
+
+		var last []IntroBlock // Accepted runs of this list, by last statement
+
+		for i, stmt := range stmts {
+			decl := astutil.NodeIndexOf(stmt)
+			if _, ok := staying[decl]; !ok {
+				continue
+			}
+
+			block, ok := ts.introBlock(stmts, i)
+			if !ok {
+				continue
+			}
+
+			if j := slices.IndexFunc(last, func(b IntroBlock) bool { return b.Last == block.Last }); j >= 0 {
+				last[j].Vars = append(last[j].Vars, block.Vars...)
+				continue
+			}
+
+			last = append(last, block)
+		}
+
+		blocks = append(blocks, last...)
+	}
+
+	return blocks
+}
+
+// introBlock checks whether the declaration stmts[i] starts a run of statements that can be wrapped in a block.
+func (ts Stage) introBlock(stmts []inspector.Cursor, i int) (IntroBlock, bool) {
+	var (
+		vars []*types.Var
+		objs []types.Object
+	)
+
+	for obj := range ts.definedObjects(stmts[i].Node()) {
+		if v, ok := obj.(*types.Var); ok {
+			vars, objs = append(vars, v), append(objs, v)
+		}
+	}
+
+	if len(vars) == 0 {
+		return IntroBlock{}, false
+	}
+
+	// Find the last statement using the declared variables
+	end := i
+	for j := i + 1; j < len(stmts); j++ {
+		if ts.usesAny(stmts[j], objs) {
+			end = j
+		}
+	}
+
+	if end == i || end == len(stmts)-1 {
+		return IntroBlock{}, false // Unused or used until the end of the list
+	}
+
+	// Declarations of the run must not be used after it
+	var declared []types.Object
+
+	for _, stmt := range stmts[i : end+1] {
+		if _, ok := stmt.Node().(*ast.LabeledStmt); ok {
+			return IntroBlock{}, false // Jumps to the label from outside the block would be invalid
+		}
+
+		if ts.redeclares(stmt.Node()) {
+			return IntroBlock{}, false // Would declare a new variable shadowing the reused one in the block
+		}
+
+		for obj := range ts.definedObjects(stmt.Node()) {
+			declared = append(declared, obj)
+		}
+	}
+
+	for _, stmt := range stmts[end+1:] {
+		if ts.usesAny(stmt, declared) {
+			return IntroBlock{}, false
+		}
+	}
+
+	return IntroBlock{Decl: astutil.NodeIndexOf(stmts[i]), Last: astutil.NodeIndexOf(stmts[end]), Vars: vars}, true
+}
+
+// definedObjects returns the objects declared by a statement in the scope of its list.
+func (ts Stage) definedObjects(stmt ast.Node) iter.Seq[types.Object] {
+	var ids []*ast.Ident
+
+	switch n := stmt.(type) {
+	case *ast.AssignStmt:
+		for _, expr := range n.Lhs {
+			if id, ok := expr.(*ast.Ident); ok {
+				ids = append(ids, id)
+			}
+		}
+
+	case *ast.DeclStmt:
+		if gen, ok := n.Decl.(*ast.GenDecl); ok {
+			for _, spec := range gen.Specs {
+				switch spec := spec.(type) {
+				case *ast.ValueSpec:
+					ids = append(ids, spec.Names...)
+
+				case *ast.TypeSpec:
+					ids = append(ids, spec.Name)
+				}
+			}
+		}
+	}
+
+	return func(yield func(types.Object) bool) {
+		for _, id := range ids {
+			if obj := ts.TypesInfo.Defs[id]; obj != nil && !yield(obj) {
+				return
+			}
+		}
+	}
+}
+
+// redeclares reports whether the statement is a short variable declaration assigning to an existing variable.
+func (ts Stage) redeclares(stmt ast.Node) bool {
+	asgn, ok := stmt.(*ast.AssignStmt)
+	if !ok || asgn.Tok != token.DEFINE {
+		return false
+	}
+
+	for _, expr := range asgn.Lhs {
+		if id, ok := expr.(*ast.Ident); ok && ts.TypesInfo.Uses[id] != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// usesAny reports whether the statement uses one of the objects.
+func (ts Stage) usesAny(stmt inspector.Cursor, objs []types.Object) bool {
+	for c := range stmt.Preorder((*ast.Ident)(nil)) {
+		if obj := ts.TypesInfo.Uses[c.Node().(*ast.Ident)]; obj != nil && slices.Contains(objs, obj) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package target
+
+import (
+	"go/ast"
+	"iter"
+
+	"golang.org/x/tools/go/ast/edge"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"fillmore-labs.com/scopeguard/internal/astutil"
+	"fillmore-labs.com/scopeguard/internal/target/check"
+	"fillmore-labs.com/scopeguard/internal/usage"
+)
+
+// Join represents adjacent short variable declarations that can be joined into a single one.
+type Join struct {
+	Decls []astutil.NodeIndex // The adjacent declarations, in source order
+}
+
+// Joins finds runs of adjacent, independent short variable declarations that stay in their scope:
+//
+//	a := 1
+//	b := "b"
+//
+// can be written as a, b := 1, "b". Only declarations of new variables with constant initializers
+// are joined, so they are independent of each other and the evaluation order is irrelevant.
+// Declarations separated by blank lines or comments are not joined.
+func (ts Stage) Joins(cf astutil.CurrentFile, body inspector.Cursor, scopeRanges iter.Seq2[astutil.NodeIndex, usage.ScopeRange]) []Join {
+	staying := make(map[astutil.NodeIndex]struct{})
+
+	for decl, scopeRange := range scopeRanges {
+		if decl.Valid() && scopeRange.Usage == scopeRange.Decl {
+			staying[decl] = struct{}{}
+		}
+	}
+
+	var joins []Join
+
+	for list := range body.Preorder((*ast.BlockStmt)(nil), (*ast.CaseClause)(nil), (*ast.CommClause)(nil)) {
+		var run []inspector.Cursor
+
+		for stmt := range stmtList(list) {
+			if !ts.joinable(cf, staying, stmt) {
+				joins = appendJoin(joins, run)
+				run = run[:0]
+
+				continue
+			}
+
+			// Blank lines or comments between declarations likely separate them intentionally
+			if len(run) > 0 && ts.separated(cf, run[len(run)-1].Node(), stmt.Node()) {
+				joins = appendJoin(joins, run)
+				run = run[:0]
+			}
+
+			run = append(run, stmt)
+		}
+
+		joins = appendJoin(joins, run)
+	}
+
+	return joins
+}
+
+// stmtList yields the statements of a block or clause body.
+func stmtList(list inspector.Cursor) iter.Seq[inspector.Cursor] {
+	var kind edge.Kind
+
+	switch list.Node().(type) {
+	case *ast.BlockStmt:
+		kind = edge.BlockStmt_List
+
+	case *ast.CaseClause:
+		kind = edge.CaseClause_Body
+
+	case *ast.CommClause:
+		kind = edge.CommClause_Body
+	}
+
+	return func(yield func(inspector.Cursor) bool) {
+		for c := range list.Children() {
+			if k, _ := c.ParentEdge(); k == kind && !yield(c) {
+				return
+			}
+		}
+	}
+}
+
+// joinable reports whether stmt is an inert short variable declaration staying in its scope.
+func (ts Stage) joinable(cf astutil.CurrentFile, staying map[astutil.NodeIndex]struct{}, stmt inspector.Cursor) bool {
+	asgn, ok := stmt.Node().(*ast.AssignStmt)
+	if !ok || len(asgn.Lhs) != len(asgn.Rhs) || !check.InertShortDecl(ts.TypesInfo, asgn) || cf.NoLintComment(asgn.Pos()) {
+		return false
+	}
+
+	_, ok = staying[astutil.NodeIndexOf(stmt)]
+
+	return ok
+}
+
+// separated reports whether a blank line or a comment lies between two adjacent statements.
+func (ts Stage) separated(cf astutil.CurrentFile, prev, next ast.Node) bool {
+	return cf.Span(prev.End(), next.Pos()) > 2 || cf.HasComment(prev.End(), next.Pos())
+}
+
+// appendJoin appends run as a join, if it has at least two declarations.
+func appendJoin(joins []Join, run []inspector.Cursor) []Join {
+	if len(run) < 2 {
+		return joins
+	}
+
+	decls := make([]astutil.NodeIndex, len(run))
+	for i, c := range run {
+		decls[i] = astutil.NodeIndexOf(c)
+	}
+
+	return append(joins, Join{Decls: decls})
+}
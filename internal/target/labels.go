@@ -19,27 +19,38 @@ package target
 import (
 	"go/ast"
 	"go/token"
+	"go/types"
 	"slices"
 
 	"golang.org/x/tools/go/ast/inspector"
 )
 
-// sortedLabels collects all labeled statement positions in the function body.
+// sortedLabels collects the positions of labeled statements targeted by goto statements in the function body.
 //
-// These positions are used to prevent declaration moves across labels,
-// which could change program semantics.
+// These positions are used to prevent declaration moves across labels, since a backward goto
+// would re-execute a moved declaration. Labels only targeted by break or continue statements
+// don't repeat code outside their loop, so they are no barrier; moves into loops are checked separately.
 //
 // Returns nil if no labels are found, otherwise returns sorted positions.
-func sortedLabels(body inspector.Cursor) []token.Pos {
-	var labels []token.Pos
-	for l := range body.Preorder((*ast.LabeledStmt)(nil)) {
-		labels = append(labels, l.Node().Pos())
+func sortedLabels(info *types.Info, body inspector.Cursor) []token.Pos {
+	targets := make(map[types.Object]struct{})
+	for b := range body.Preorder((*ast.BranchStmt)(nil)) {
+		if branch := b.Node().(*ast.BranchStmt); branch.Tok == token.GOTO && branch.Label != nil {
+			targets[info.Uses[branch.Label]] = struct{}{}
+		}
 	}
 
-	if len(labels) == 0 {
+	if len(targets) == 0 {
 		return nil
 	}
 
+	var labels []token.Pos
+	for l := range body.Preorder((*ast.LabeledStmt)(nil)) {
+		if _, ok := targets[info.Defs[l.Node().(*ast.LabeledStmt).Label]]; ok {
+			labels = append(labels, l.Node().Pos())
+		}
+	}
+
 	// Sort positions to enable binary search during candidate analysis.
 	// While Preorder traverses in depth-first order (which typically matches source order),
 	// explicit sorting ensures correctness and is negligible overhead.
@@ -0,0 +1,170 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package target
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"slices"
+
+	"golang.org/x/tools/go/ast/edge"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"fillmore-labs.com/scopeguard/internal/astutil"
+	"fillmore-labs.com/scopeguard/internal/target/check"
+)
+
+// SilencedDeclarations finds trailing declarations of the function body whose variables are only used
+// by blank assignments silencing the compiler, like:
+//
+//	x := compute()
+//	_ = x
+//	}
+//
+// Such variables are effectively unused. The declaration is reported for removal together with the silencers.
+func (cm CandidateManager) SilencedDeclarations(info *types.Info, cf astutil.CurrentFile, body inspector.Cursor) []MoveTarget {
+	block, ok := body.Node().(*ast.BlockStmt)
+	if !ok || len(block.List) < 2 {
+		return nil
+	}
+
+	// Find the start of the trailing silencers
+	start := len(block.List)
+	for start > 1 && silencer(block.List[start-1]) {
+		start--
+	}
+
+	if start == len(block.List) {
+		return nil
+	}
+
+	declCursor := body.ChildAt(edge.BlockStmt_List, start-1)
+	decl := astutil.NodeIndexOf(declCursor)
+	if _, ok := cm.candidates[decl]; ok || cf.NoLintComment(declCursor.Node().Pos()) {
+		return nil
+	}
+
+	vars, ok := declaredVars(info, declCursor.Node())
+	if !ok {
+		return nil
+	}
+
+	var (
+		names     []string
+		silencers []astutil.NodeIndex
+	)
+
+	for _, v := range vars {
+		names = append(names, v.Name())
+	}
+
+	for i, stmt := range block.List[start:] {
+		for _, expr := range stmt.(*ast.AssignStmt).Rhs {
+			id := ast.Unparen(expr).(*ast.Ident)
+
+			v, ok := info.Uses[id].(*types.Var)
+			if !ok || !slices.Contains(vars, v) {
+				return nil // Silences a variable declared elsewhere
+			}
+		}
+
+		silencers = append(silencers, astutil.NodeIndexOf(body.ChildAt(edge.BlockStmt_List, start+i)))
+	}
+
+	status := check.MoveAllowed
+	if cf.Generated() {
+		status = check.MoveBlockedGenerated
+	}
+
+	return []MoveTarget{{MovableDecl: MovableDecl{Decl: decl, Unused: names}, Silencers: silencers, Status: status}}
+}
+
+// silencer reports whether the statement only assigns identifiers to blanks, like `_ = x`.
+func silencer(stmt ast.Stmt) bool {
+	asgn, ok := stmt.(*ast.AssignStmt)
+	if !ok || asgn.Tok != token.ASSIGN || len(asgn.Lhs) != len(asgn.Rhs) {
+		return false
+	}
+
+	for _, expr := range asgn.Lhs {
+		if id, ok := expr.(*ast.Ident); !ok || id.Name != "_" {
+			return false
+		}
+	}
+
+	for _, expr := range asgn.Rhs {
+		if _, ok := ast.Unparen(expr).(*ast.Ident); !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// declaredVars returns the variables newly declared by a short variable or var declaration.
+// It fails for redeclarations, which assign variables from an outer declaration.
+func declaredVars(info *types.Info, node ast.Node) ([]*types.Var, bool) {
+	var ids []*ast.Ident
+
+	switch n := node.(type) {
+	case *ast.AssignStmt:
+		if n.Tok != token.DEFINE {
+			return nil, false
+		}
+
+		for _, expr := range n.Lhs {
+			id, ok := expr.(*ast.Ident)
+			if !ok {
+				return nil, false
+			}
+			ids = append(ids, id)
+		}
+
+	case *ast.DeclStmt:
+		gen, ok := n.Decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.VAR {
+			return nil, false
+		}
+
+		for _, spec := range gen.Specs {
+			if vspec, ok := spec.(*ast.ValueSpec); ok {
+				ids = append(ids, vspec.Names...)
+			}
+		}
+
+	default:
+		return nil, false
+	}
+
+	var vars []*types.Var
+
+	for _, id := range ids {
+		if id.Name == "_" {
+			continue // blank identifier
+		}
+
+		v, ok := info.Defs[id].(*types.Var)
+		if !ok {
+			return nil, false // Redeclaration
+		}
+
+		vars = append(vars, v)
+	}
+
+	return vars, len(vars) > 0
+}
@@ -0,0 +1,186 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package target
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/ast/edge"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"fillmore-labs.com/scopeguard/internal/astutil"
+	"fillmore-labs.com/scopeguard/internal/target/check"
+)
+
+// Swap represents a move blocked in conservative mode by a single statement directly following the declaration.
+type Swap struct {
+	Move  MoveTarget        // The blocked move
+	Stmt  astutil.NodeIndex // The statement blocking the move
+	Inert bool              // Whether the declaration is inert, so swapping keeps the order of side effects
+}
+
+// Swaps finds moves blocked by intervening statements where swapping the declaration with the
+// single statement following it unblocks the move:
+//
+//	x := f()
+//	logln("start")
+//	if x > 0 {
+//
+// can move x into the if statement after swapping the declaration with logln("start"), since both
+// reference no common variables, the declaration reads no shared memory and no other statement intervenes.
+func (ts Stage) Swaps(body inspector.Cursor, moves []MoveTarget) []Swap {
+	var swaps []Swap
+
+	for _, move := range moves {
+		if swap, ok := ts.swap(body, move); ok {
+			swaps = append(swaps, swap)
+		}
+	}
+
+	return swaps
+}
+
+// swap checks whether the move is only blocked by the statement directly following the declaration.
+func (ts Stage) swap(body inspector.Cursor, move MoveTarget) (Swap, bool) {
+	if move.Status != check.MoveBlockedStatements || move.TargetNode == nil || len(move.AbsorbedDecls) > 0 {
+		return Swap{}, false
+	}
+
+	decl := move.Decl.Cursor(body.Inspector())
+	if kind, _ := decl.ParentEdge(); kind != edge.BlockStmt_List && kind != edge.CaseClause_Body && kind != edge.CommClause_Body {
+		return Swap{}, false
+	}
+
+	stmt, ok := decl.NextSibling()
+	if !ok {
+		return Swap{}, false
+	}
+
+	switch stmt.Node().(type) {
+	case *ast.BranchStmt, *ast.LabeledStmt, *ast.ReturnStmt:
+		return Swap{}, false // Control flow can't be reordered
+	}
+
+	// The statement after the blocking one must contain the target
+	next, ok := stmt.NextSibling()
+	if !ok || move.TargetNode.Pos() < next.Node().Pos() || next.Node().End() <= move.TargetNode.Pos() {
+		return Swap{}, false
+	}
+
+	// After the swap, the rest of the interval must be inert
+	start, end := stmt.Node().End(), move.TargetNode.Pos()
+	if parent, ok := body.FindByPos(start, end); ok && !check.IntervalInert(ts.TypesInfo, parent, nil, start, end) {
+		return Swap{}, false
+	}
+
+	if !ts.independent(decl, stmt) {
+		return Swap{}, false
+	}
+
+	// The blocking statement has side effects, which could change memory the declaration reads
+	if ts.readsShared(decl) {
+		return Swap{}, false
+	}
+
+	inert := check.IntervalInert(ts.TypesInfo, decl, nil, decl.Node().Pos(), decl.Node().End())
+
+	return Swap{Move: move, Stmt: astutil.NodeIndexOf(stmt), Inert: inert}, true
+}
+
+// independent reports whether two statements reference no common variables and the second one declares no
+// names referenced by the first, so they can be swapped.
+func (ts Stage) independent(first, second inspector.Cursor) bool {
+	vars := make(map[*types.Var]struct{})
+	names := make(map[string]struct{})
+
+	for c := range first.Preorder((*ast.Ident)(nil)) {
+		id := c.Node().(*ast.Ident)
+		if v, ok := ts.TypesInfo.ObjectOf(id).(*types.Var); ok {
+			vars[v] = struct{}{}
+		}
+
+		names[id.Name] = struct{}{}
+	}
+
+	for c := range second.Preorder((*ast.Ident)(nil)) {
+		id := c.Node().(*ast.Ident)
+		if v, ok := ts.TypesInfo.ObjectOf(id).(*types.Var); ok {
+			if _, ok := vars[v]; ok {
+				return false
+			}
+		}
+
+		if _, ok := names[id.Name]; ok && ts.TypesInfo.Defs[id] != nil {
+			return false // Would shadow a name referenced by the first statement after swapping
+		}
+	}
+
+	return true
+}
+
+// readsShared reports whether the declaration reads package-level variables or memory through pointers,
+// slices or maps, which other statements can modify without referencing the same variables.
+// Function literal bodies are not considered, since they are not executed by the declaration.
+func (ts Stage) readsShared(decl inspector.Cursor) bool {
+	found := false
+
+	ast.Inspect(decl.Node(), func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+
+		case *ast.Ident:
+			if v, ok := ts.TypesInfo.Uses[n].(*types.Var); ok && v.Pkg() != nil && v.Parent() == v.Pkg().Scope() {
+				found = true
+			}
+
+		case *ast.StarExpr:
+			found = ts.TypesInfo.Types[n.X].IsValue() // Dereference, not a pointer type
+
+		case *ast.SelectorExpr:
+			if sel, ok := ts.TypesInfo.Selections[n]; ok && sel.Indirect() {
+				found = true
+			}
+
+		case *ast.IndexExpr:
+			found = sharedIndex(ts.TypesInfo.TypeOf(n.X))
+
+		case *ast.SliceExpr:
+			found = sharedIndex(ts.TypesInfo.TypeOf(n.X))
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// sharedIndex reports whether indexing a value of type t reads memory shared with other values.
+func sharedIndex(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+
+	switch t.Underlying().(type) {
+	case *types.Slice, *types.Map, *types.Pointer:
+		return true
+
+	default:
+		return false
+	}
+}
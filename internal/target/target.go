@@ -18,11 +18,15 @@ package target
 
 import (
 	"context"
+	"fmt"
 	"go/ast"
 	"go/token"
 	"go/types"
+	"go/version"
 	"iter"
+	"log/slog"
 	"runtime/trace"
+	"slices"
 
 	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/ast/inspector"
@@ -45,11 +49,54 @@ type Stage struct {
 	// into control flow initializers.
 	MaxLines int
 
+	// MinSpan specifies the minimum number of lines from the declaration to the end of the usage scope
+	// for a declaration to be considered for moving.
+	MinSpan int
+
 	// Conservative specifies to only permit moves that don't cross code with potential side effects.
 	Conservative bool
 
 	// Combine determines whether to attempt combining initialization statements during scope tightening.
 	Combine bool
+
+	// MaxAbsorb limits the number of declarations combined into another one, negative values mean unlimited.
+	MaxAbsorb int
+
+	// MaxWidth limits the width of initializer lines with combined declarations, negative values mean unlimited.
+	MaxWidth int
+
+	// PreferBlock moves short variable declarations to block statements only, skipping init fields.
+	PreferBlock bool
+
+	// TargetKinds are the kinds of target scopes declarations can be moved to.
+	TargetKinds scope.Kind
+
+	// OnlyErrorVars restricts move candidates to declarations of variables named err or implementing error.
+	OnlyErrorVars bool
+
+	// KeepDocumented reports moves of var declarations with doc comments without a fix.
+	KeepDocumented bool
+
+	// SkipDocumented excludes var declarations with doc comments from move candidates.
+	SkipDocumented bool
+
+	// KeepPragmas are comments keeping declarations on their line at their scope, like "keep:top".
+	KeepPragmas []string
+
+	// ExplainUnused records why variables are unused in [MovableDecl.Causes].
+	ExplainUnused bool
+
+	// AddressHints records whether addresses of moved variables are taken in [MoveTarget.AddressTaken].
+	AddressHints bool
+
+	// LoopBodyMoves permits moving loop invariant declarations into loop bodies in files with Go 1.22 or later.
+	LoopBodyMoves bool
+
+	// GroupRelated omits absorbed declarations, which are reported with the declaration they are merged into.
+	GroupRelated bool
+
+	// Logger, if set, receives a record for each declaration not considered for moving.
+	Logger *slog.Logger
 }
 
 // SelectTargets determines which declarations can be moved to tighter scopes and where they should go.
@@ -61,16 +108,19 @@ func (ts Stage) SelectTargets(ctx context.Context, cf astutil.CurrentFile, body
 	in := body.Inspector()
 
 	// Identify all potential move candidates
-	cm := ts.CollectMoveCandidates(body, cf, usageData.AllScopeRanges())
+	cm := ts.CollectMoveCandidates(ctx, body, cf, usageData.AllScopeRanges())
 
 	// Block moves that would change variable types
 	cm.BlockMovesWithTypeChanges(usageData.AllUsages(), ts.Conservative)
 
+	// Block moves only valid after removing debug prints
+	cm.BlockMovesWithDebugPrints(usageData.AllUsages())
+
 	// Calculate unused identifiers and block moves that would lose necessary type information
 	unused := cm.BlockMovesLosingTypeInfo(usageData.AllUsages())
 
 	// Resolve Init field conflicts (possibly by combining them)
-	cm.ResolveInitFieldConflicts(in, ts.Combine)
+	cm.ResolveInitFieldConflicts(ts.Fset, in, ts.Combine, ts.MaxAbsorb, ts.MaxWidth)
 
 	if ts.Conservative {
 		// In conservative mode, blocks moves if there are intervening statements with possible side effects.
@@ -80,14 +130,40 @@ func (ts Stage) SelectTargets(ctx context.Context, cf astutil.CurrentFile, body
 	// Find declarations that become orphaned after other moves
 	orphanedDeclarations := cm.OrphanedDeclarations(usageData.AllUsages())
 
+	// Find trailing declarations only used by blank assignments
+	silenced := cm.SilencedDeclarations(ts.TypesInfo, cf, body)
+
 	// Convert candidates to the final sorted result
-	return cm.SortedMoveTargets(unused, orphanedDeclarations)
+	moves := cm.SortedMoveTargets(unused, orphanedDeclarations, silenced)
+
+	if ts.ExplainUnused {
+		// Record why variables are unused for related information
+		causes := cm.UnusedCauses(usageData.AllUsages(), unused, orphanedDeclarations)
+		for i := range moves {
+			moves[i].Causes = causes[moves[i].Decl]
+		}
+	}
+
+	if ts.AddressHints {
+		// Record address-taken variables, whose tighter scope can help escape analysis
+		taken := cm.AddressTaken(usageData.AllUsages())
+		for i := range moves {
+			moves[i].AddressTaken = moves[i].TargetNode != nil && taken[moves[i].Decl]
+		}
+	}
+
+	if ts.GroupRelated {
+		// Absorbed declarations are part of the move they are merged into
+		moves = slices.DeleteFunc(moves, func(m MoveTarget) bool { return m.Status == check.MoveAbsorbed })
+	}
+
+	return moves
 }
 
 // CollectMoveCandidates iterates through all usage scopes and determines valid target nodes
 // for declarations that can be moved to tighter scopes.
-func (ts Stage) CollectMoveCandidates(body inspector.Cursor, cf astutil.CurrentFile, scopeRanges iter.Seq2[astutil.NodeIndex, usage.ScopeRange]) CandidateManager {
-	labels := sortedLabels(body)
+func (ts Stage) CollectMoveCandidates(ctx context.Context, body inspector.Cursor, cf astutil.CurrentFile, scopeRanges iter.Seq2[astutil.NodeIndex, usage.ScopeRange]) CandidateManager {
+	labels := sortedLabels(ts.TypesInfo, body)
 
 	cm := newCandidateManager()
 
@@ -103,9 +179,17 @@ func (ts Stage) CollectMoveCandidates(body inspector.Cursor, cf astutil.CurrentF
 			continue // Cannot move, already at the innermost scope
 		}
 
-		if m, ok := ts.analyzeCandidate(in, cf, decl, declScope, usageScope, labels); ok {
-			cm.candidates[decl] = m
+		m, reason := ts.analyzeCandidate(in, cf, decl, declScope, usageScope, labels)
+		if reason != "" {
+			ts.logSkip(ctx, decl.Node(in), reason)
+			continue
+		}
+
+		if m.status == check.MoveBlockedGenerated {
+			ts.logSkip(ctx, decl.Node(in), "generated file, fix suppressed")
 		}
+
+		cm.candidates[decl] = m
 	}
 
 	return cm
@@ -113,40 +197,84 @@ func (ts Stage) CollectMoveCandidates(body inspector.Cursor, cf astutil.CurrentF
 
 // analyzeCandidate evaluates a single declaration to see if it can be moved.
 // It handles:
-//   - Filtering out suppressed declarations (nolint, maxLines)
+//   - Filtering out suppressed declarations (nolint, maxLines, minSpan)
 //   - Finding safe scopes that avoid semantic hazards
 //   - Selecting appropriate target AST nodes based on the declaration type
-func (ts Stage) analyzeCandidate(in *inspector.Inspector, cf astutil.CurrentFile, decl astutil.NodeIndex, declScope, usageScope *types.Scope, labels []token.Pos) (MoveCandidate, bool) {
+//
+// Returns a non-empty reason when the declaration is not a move candidate.
+func (ts Stage) analyzeCandidate(in *inspector.Inspector, cf astutil.CurrentFile, decl astutil.NodeIndex, declScope, usageScope *types.Scope, labels []token.Pos) (MoveCandidate, string) {
 	declCursor := decl.Cursor(in)
 	declNode := declCursor.Node()
 
 	// Find the tightest scope we can move to (avoiding loops, closures)
-	safeScope := ts.FindSafeScope(declScope, usageScope)
+	safeScope, loops := ts.safeScope(cf, declCursor, declScope, usageScope)
 	switch safeScope {
 	case nil:
 		astutil.InternalError(ts.Pass, declNode, "Invalid scope calculations")
-		return MoveCandidate{}, false
+		return MoveCandidate{}, "invalid scope calculations"
 
 	case declScope: // No scope tightening possible
-		return MoveCandidate{}, false
+		return MoveCandidate{}, boundaryReason(ts.Boundary(declScope, usageScope, loops))
 	}
 
 	// Determine assigned identifiers and whether the declaration can be moved to an init field
 	identifiers, onlyBlock := declInfo(declNode, cf, ts.MaxLines)
 	if identifiers == nil {
-		return MoveCandidate{}, false // Unsupported declaration type
+		return MoveCandidate{}, fmt.Sprintf("unsupported declaration type %T", declNode)
 	}
 
 	declPos := declNode.Pos()
 
+	if cf.NoLintComment(declPos) {
+		return MoveCandidate{}, "nolint directive"
+	}
+
+	if cf.PragmaComment(declPos, ts.KeepPragmas) {
+		return MoveCandidate{}, "keep pragma"
+	}
+
+	if ts.OnlyErrorVars && !check.ErrorVar(ts.TypesInfo, declNode) {
+		return MoveCandidate{}, "no error variable"
+	}
+
+	documented := documentedDecl(declNode)
+	if documented && ts.SkipDocumented {
+		return MoveCandidate{}, "documented declaration"
+	}
+
+	if check.ContextCancel(ts.TypesInfo, declNode) {
+		return MoveCandidate{}, "context cancellation function"
+	}
+
+	if check.RecursiveClosure(ts.TypesInfo, declCursor) {
+		return MoveCandidate{}, "recursive closure"
+	}
+
+	if ts.MinSpan > 0 && cf.Span(declPos, usageScope.End()) < ts.MinSpan {
+		return MoveCandidate{}, "scope span below min span"
+	}
+
 	// Find the nearest label after this declaration.
 	// We cannot move the declaration past it to avoid placing it inside a loop.
 	labelBarrier := nextLabel(labels, declPos)
 
 	// Find the target AST node for the move
-	targetNode := ts.TargetNode(declScope, safeScope, labelBarrier, onlyBlock)
-	if targetNode == nil || cf.NoLintComment(declPos) {
-		return MoveCandidate{}, false
+	kinds := ts.TargetKinds
+	if onlyBlock != "" || ts.PreferBlock {
+		kinds &= scope.BlockKinds
+	}
+
+	targetNode := ts.TargetNode(declScope, safeScope, labelBarrier, kinds)
+	if targetNode == nil {
+		if onlyBlock != "" && ts.TargetNode(declScope, safeScope, labelBarrier, ts.TargetKinds) != nil {
+			return MoveCandidate{}, onlyBlock // Only the block restriction prevents the move
+		}
+
+		return MoveCandidate{}, "no suitable target scope"
+	}
+
+	if check.UnreachableTarget(ts.TypesInfo, targetNode, identifiers) {
+		return MoveCandidate{}, "uses unreachable in target scope"
 	}
 
 	// Create a move candidate
@@ -159,22 +287,93 @@ func (ts Stage) analyzeCandidate(in *inspector.Inspector, cf astutil.CurrentFile
 		m.status = check.SafetyCheck(ts.TypesInfo, declCursor, declScope, safeScope, identifiers)
 	}
 
-	return m, true
+	if m.status.Movable() && documented && ts.KeepDocumented {
+		m.status = check.MoveBlockedDocumented
+	}
+
+	return m, ""
 }
 
-// declInfo extracts assigned identifiers and whether the move is restricted to block statements only.
-func declInfo(declNode ast.Node, cf astutil.CurrentFile, maxLines int) (identifiers iter.Seq[string], onlyBlock bool) {
+// safeScope finds the tightest scope the declaration can be moved to.
+//
+// Loop bodies are only considered when [Stage.LoopBodyMoves] is enabled, the file uses per-iteration
+// loop variable semantics (Go 1.22 or later) and a fresh variable per iteration can't change semantics.
+// Declarations read by subtests are kept out of loop bodies.
+//
+// Also reports whether loop bodies were considered.
+func (ts Stage) safeScope(cf astutil.CurrentFile, decl inspector.Cursor, declScope, usageScope *types.Scope) (*types.Scope, bool) {
+	safeScope := ts.FindSafeScope(declScope, usageScope)
+	if safeScope == usageScope || !ts.LoopBodyMoves {
+		return safeScope, false
+	}
+
+	if version.Compare(cf.GoVersion(ts.TypesInfo), "go1.22") < 0 || !check.LoopInvariant(ts.TypesInfo, decl) {
+		return safeScope, false
+	}
+
+	if check.SubtestSetup(ts.TypesInfo, decl) {
+		return safeScope, false // Shared setup of table-driven subtests is hoisted intentionally
+	}
+
+	return ts.FindSafeLoopScope(declScope, usageScope), true
+}
+
+// boundaryReason describes the scope boundary that prevents moving a declaration.
+func boundaryReason(boundary ast.Node) string {
+	switch boundary.(type) {
+	case *ast.ForStmt, *ast.RangeStmt:
+		return "usage crosses a loop boundary"
+
+	case *ast.FuncType:
+		return "usage crosses a function literal boundary"
+
+	default:
+		return "no scope tightening possible"
+	}
+}
+
+// logSkip logs a declaration not considered for moving when a [Stage.Logger] is set.
+func (ts Stage) logSkip(ctx context.Context, node ast.Node, reason string) {
+	if ts.Logger == nil {
+		return
+	}
+
+	ts.Logger.LogAttrs(ctx, slog.LevelInfo, "Skipping declaration",
+		slog.String("position", ts.Fset.Position(node.Pos()).String()),
+		slog.String("reason", reason))
+}
+
+// documentedDecl reports whether the declaration is a var declaration with a doc comment.
+func documentedDecl(declNode ast.Node) bool {
+	declStmt, ok := declNode.(*ast.DeclStmt)
+	if !ok {
+		return false
+	}
+
+	g, ok := declStmt.Decl.(*ast.GenDecl)
+
+	return ok && g.Doc != nil
+}
+
+// declInfo extracts assigned identifiers and why the move is restricted to block statements only.
+//
+// onlyBlock is empty when the declaration can also be moved to an init field.
+func declInfo(declNode ast.Node, cf astutil.CurrentFile, maxLines int) (identifiers iter.Seq[string], onlyBlock string) {
 	switch n := declNode.(type) {
 	case *ast.AssignStmt:
 		// Short declarations can go to init fields if they're small enough
-		return astutil.AllAssignedNames(n), maxLines > 0 && cf.Lines(declNode) > maxLines
+		if maxLines > 0 && cf.Lines(declNode) > maxLines {
+			return astutil.AllAssignedNames(n), "declaration exceeds max lines"
+		}
+
+		return astutil.AllAssignedNames(n), ""
 
 	case *ast.DeclStmt:
 		// var declarations can only go to block statements (not init fields)
-		return astutil.AllDeclaredNames(n), true
+		return astutil.AllDeclaredNames(n), "var declaration needs a block"
 
 	default:
 		// Unsupported declaration type
-		return nil, false
+		return nil, ""
 	}
 }
@@ -112,18 +112,19 @@ func TestTargets(t *testing.T) {
 				TargetScope:  scope.NewTargetScope(scopes),
 				MaxLines:     -1,
 				Conservative: false,
+				TargetKinds:  scope.AllKinds,
 			}
 
 			currentFile := astutil.NewCurrentFile(fset, f)
 
 			usageData, _ := us.TrackUsage(t.Context(), body, fun)
-			cm := ts.CollectMoveCandidates(body, currentFile, usageData.AllScopeRanges())
+			cm := ts.CollectMoveCandidates(t.Context(), body, currentFile, usageData.AllScopeRanges())
 
 			// when
 			unused := cm.BlockMovesLosingTypeInfo(usageData.AllUsages())
 
 			// then
-			mt := cm.SortedMoveTargets(unused, nil)
+			mt := cm.SortedMoveTargets(unused, nil, nil)
 
 			// For this test setup, we expect at most one move target relevant to the test case
 			// Check if we found *any* target matching our expectation
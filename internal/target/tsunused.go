@@ -0,0 +1,85 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package target
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/ast/edge"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"fillmore-labs.com/scopeguard/internal/astutil"
+)
+
+// TypeSwitchUnused represents a case clause of a type switch not reading the variable bound by the switch.
+type TypeSwitchUnused struct {
+	Clause astutil.NodeIndex // The case clause
+	Bind   *ast.Ident        // The variable bound by the type switch
+	Var    *types.Var        // The implicitly declared variable of the case clause
+}
+
+// TypeSwitchUnused finds case clauses of type switches whose implicitly declared variable is never read:
+//
+//	switch x := y.(type) {
+//	case int:
+//		use(x)
+//	case string:
+//		return // x is unused
+//	}
+//
+// Each clause declares its own variable with the type of the case, so the binding is not a normal
+// declaration to move. The compiler only reports variables unused in all clauses.
+func (ts Stage) TypeSwitchUnused(body inspector.Cursor) []TypeSwitchUnused {
+	var unused []TypeSwitchUnused
+
+	for c := range body.Preorder((*ast.TypeSwitchStmt)(nil)) {
+		n := c.Node().(*ast.TypeSwitchStmt)
+
+		asgn, ok := n.Assign.(*ast.AssignStmt)
+		if !ok || len(asgn.Lhs) != 1 {
+			continue
+		}
+
+		bind, ok := asgn.Lhs[0].(*ast.Ident)
+		if !ok || bind.Name == "_" {
+			continue
+		}
+
+		for clause := range c.ChildAt(edge.TypeSwitchStmt_Body, -1).Children() {
+			v, ok := ts.TypesInfo.Implicits[clause.Node()].(*types.Var)
+			if !ok || ts.clauseReads(clause, v) {
+				continue
+			}
+
+			unused = append(unused, TypeSwitchUnused{Clause: astutil.NodeIndexOf(clause), Bind: bind, Var: v})
+		}
+	}
+
+	return unused
+}
+
+// clauseReads reports whether v is used in the statements of the clause.
+func (ts Stage) clauseReads(clause inspector.Cursor, v *types.Var) bool {
+	for i := range clause.Preorder((*ast.Ident)(nil)) {
+		if ts.TypesInfo.Uses[i.Node().(*ast.Ident)] == v {
+			return true
+		}
+	}
+
+	return false
+}
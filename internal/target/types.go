@@ -24,16 +24,26 @@ import (
 
 // MoveTarget represents a declaration that can be moved to a tighter scope.
 type MoveTarget struct {
-	MovableDecl                 // The declaration to move
-	TargetNode    ast.Node      // The node with the target scope (e.g., *[ast.IfStmt], *[ast.BlockStmt])
-	AbsorbedDecls []MovableDecl // Additional declarations merged into this one
-	Status        MoveStatus    // Status indicating if the move is safe or why it isn't
+	MovableDecl                       // The declaration to move
+	TargetNode    ast.Node            // The node with the target scope (e.g., *[ast.IfStmt], *[ast.BlockStmt])
+	AbsorbedDecls []MovableDecl       // Additional declarations merged into this one
+	Silencers     []astutil.NodeIndex // Blank assignments of unused variables, removed with the declaration
+	Status        MoveStatus          // Status indicating if the move is safe or why it isn't
+	AddressTaken  bool                // Whether the address of a moved variable is taken, if noted
 }
 
 // MovableDecl represents a declaration that can be moved to another scope in the code analysis process.
 type MovableDecl struct {
 	Decl   astutil.NodeIndex // Inspector index of the declaration statement to move
 	Unused []string          // Unused identifiers in this declaration
+	Causes []UnusedCause     // Why identifiers are unused, if explained
+}
+
+// UnusedCause explains why a variable of a declaration is unused.
+type UnusedCause struct {
+	Name  string            // The unused variable
+	Decl  astutil.NodeIndex // The reassignment overwriting the value, or the moved declaration reading it
+	Moved bool              // Whether Decl is a moved declaration, leaving this one without reads
 }
 
 // MoveStatus indicates if a move is safe or why it isn't.
@@ -41,5 +51,6 @@ type MovableDecl struct {
 // a declaration (e.g., variable shadowing, scope conflicts).
 type MoveStatus interface {
 	Movable() bool
+	Reason() string
 	String() string
 }
@@ -0,0 +1,188 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package usage
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/ast/edge"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"fillmore-labs.com/scopeguard/internal/astutil"
+)
+
+// handleBranchInit checks whether the zero values of a var declaration without initializers
+// are dead because the immediately following if statement assigns every variable on all branches:
+//
+//	var x int
+//	if c {
+//		x = 1
+//	} else {
+//		x = 2
+//	}
+//
+// Branches are evaluated structurally: a variable must be assigned before it is mentioned otherwise,
+// and a branch ending in a return statement does not reach the following statements at all.
+func (c *collector) handleBranchInit(decl inspector.Cursor, gen *ast.GenDecl) {
+	switch kind, _ := decl.ParentEdge(); kind {
+	case edge.BlockStmt_List, edge.CaseClause_Body, edge.CommClause_Body:
+
+	default:
+		return
+	}
+
+	next, ok := decl.NextSibling()
+	if !ok {
+		return
+	}
+
+	ifStmt, ok := next.Node().(*ast.IfStmt)
+	if !ok || ifStmt.Else == nil {
+		return
+	}
+
+	var vars []*types.Var
+
+	for _, spec := range gen.Specs {
+		vspec, ok := spec.(*ast.ValueSpec)
+		if !ok || len(vspec.Values) > 0 {
+			continue
+		}
+
+		for _, id := range vspec.Names {
+			v, ok := c.TypesInfo.Defs[id].(*types.Var)
+			if !ok {
+				continue
+			}
+
+			if c.ifAssigns(ifStmt, v) {
+				vars = append(vars, v)
+			}
+		}
+	}
+
+	if len(vars) == 0 {
+		return
+	}
+
+	c.branchInits = append(c.branchInits, BranchInit{
+		Decl: astutil.NodeIndexOf(decl),
+		If:   astutil.NodeIndexOf(next),
+		Vars: vars,
+	})
+}
+
+// ifAssigns reports whether v is assigned on every branch of the if statement before being read.
+func (c *collector) ifAssigns(n *ast.IfStmt, v *types.Var) bool {
+	if n.Else == nil || c.mentions(n.Init, v) || c.mentions(n.Cond, v) {
+		return false
+	}
+
+	if !c.listAssigns(n.Body.List, v) {
+		return false
+	}
+
+	switch e := n.Else.(type) {
+	case *ast.BlockStmt:
+		return c.listAssigns(e.List, v)
+
+	case *ast.IfStmt:
+		return c.ifAssigns(e, v)
+
+	default:
+		return false
+	}
+}
+
+// listAssigns reports whether a statement list assigns v before any other mention or leaves it with a return statement.
+func (c *collector) listAssigns(list []ast.Stmt, v *types.Var) bool {
+	for _, stmt := range list {
+		switch s := stmt.(type) {
+		case *ast.AssignStmt:
+			if s.Tok == token.ASSIGN && c.assignsVar(s, v) {
+				return true
+			}
+
+		case *ast.IfStmt:
+			if c.ifAssigns(s, v) {
+				return true
+			}
+
+		case *ast.BlockStmt:
+			if c.listAssigns(s.List, v) {
+				return true
+			}
+
+		case *ast.ReturnStmt:
+			return !c.mentions(s, v)
+
+		case *ast.BranchStmt, *ast.LabeledStmt:
+			return false // Control flow we don't follow
+		}
+
+		if c.mentions(stmt, v) {
+			return false
+		}
+	}
+
+	return false
+}
+
+// assignsVar reports whether the assignment writes v without reading it.
+func (c *collector) assignsVar(s *ast.AssignStmt, v *types.Var) bool {
+	for _, expr := range s.Rhs {
+		if c.mentions(expr, v) {
+			return false
+		}
+	}
+
+	assigned := false
+
+	for _, expr := range s.Lhs {
+		if id, ok := ast.Unparen(expr).(*ast.Ident); ok {
+			assigned = assigned || c.TypesInfo.Uses[id] == v
+			continue
+		}
+
+		if c.mentions(expr, v) {
+			return false // v.f = ..., v[i] = ...
+		}
+	}
+
+	return assigned
+}
+
+// mentions reports whether v is referenced anywhere in the node.
+func (c *collector) mentions(n ast.Node, v *types.Var) bool {
+	if n == nil {
+		return false
+	}
+
+	found := false
+
+	ast.Inspect(n, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && c.TypesInfo.Uses[id] == v {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
@@ -0,0 +1,205 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package usage
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/ast/edge"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"fillmore-labs.com/scopeguard/internal/astutil"
+)
+
+// handleGoCapture checks whether variables of a declaration are only read inside a single function literal
+// started as a goroutine:
+//
+//	x := compute()
+//	go func() {
+//		use(x)
+//	}()
+//
+// Passing such variables as parameters makes the goroutine independent of later mutations.
+func (c *collector) handleGoCapture(decl inspector.Cursor) {
+	switch kind, _ := decl.ParentEdge(); kind {
+	case edge.BlockStmt_List, edge.CaseClause_Body, edge.CommClause_Body:
+
+	default:
+		return
+	}
+
+	var captures []GoCapture
+
+	for _, v := range c.newVars(decl.Node()) {
+		goStmt, ok := c.capturingGoStmt(decl, v)
+		if !ok {
+			continue
+		}
+
+		idx := astutil.NodeIndexOf(goStmt)
+
+		found := false
+		for i := range captures {
+			if captures[i].Go == idx {
+				captures[i].Vars, found = append(captures[i].Vars, v), true
+				break
+			}
+		}
+
+		if !found {
+			captures = append(captures, GoCapture{Decl: astutil.NodeIndexOf(decl), Go: idx, Vars: []*types.Var{v}})
+		}
+	}
+
+	c.goCaptures = append(c.goCaptures, captures...)
+}
+
+// newVars returns the variables newly declared by a short variable or var declaration.
+func (c *collector) newVars(node ast.Node) []*types.Var {
+	var ids []*ast.Ident
+
+	switch n := node.(type) {
+	case *ast.AssignStmt:
+		for _, expr := range n.Lhs {
+			if id, ok := expr.(*ast.Ident); ok {
+				ids = append(ids, id)
+			}
+		}
+
+	case *ast.DeclStmt:
+		if gen, ok := n.Decl.(*ast.GenDecl); ok {
+			for _, spec := range gen.Specs {
+				if vspec, ok := spec.(*ast.ValueSpec); ok {
+					ids = append(ids, vspec.Names...)
+				}
+			}
+		}
+	}
+
+	var vars []*types.Var
+
+	for _, id := range ids {
+		if v, ok := c.TypesInfo.Defs[id].(*types.Var); ok {
+			vars = append(vars, v)
+		}
+	}
+
+	return vars
+}
+
+// capturingGoStmt returns the go statement whose function literal contains all uses of v
+// following the declaration, provided v is only read there.
+func (c *collector) capturingGoStmt(decl inspector.Cursor, v *types.Var) (inspector.Cursor, bool) {
+	var goStmt inspector.Cursor
+
+	for stmt, ok := decl.NextSibling(); ok; stmt, ok = stmt.NextSibling() {
+		for i := range stmt.Preorder((*ast.Ident)(nil)) {
+			if c.TypesInfo.Uses[i.Node().(*ast.Ident)] != v {
+				continue
+			}
+
+			if directlyAssigned(i) || modified(c.TypesInfo, i) {
+				return inspector.Cursor{}, false
+			}
+
+			g, ok := outermostGoLit(i, stmt)
+			if !ok || goStmt != (inspector.Cursor{}) && g != goStmt {
+				return inspector.Cursor{}, false // Used outside of a goroutine or in several
+			}
+
+			goStmt = g
+		}
+	}
+
+	return goStmt, goStmt != (inspector.Cursor{})
+}
+
+// outermostGoLit returns the outermost go statement within stmt calling a function literal containing id.
+func outermostGoLit(id, stmt inspector.Cursor) (goStmt inspector.Cursor, found bool) {
+	for c := id; c != stmt; c = c.Parent() {
+		if _, ok := c.Node().(*ast.FuncLit); !ok {
+			continue
+		}
+
+		if kind, _ := c.ParentEdge(); kind != edge.CallExpr_Fun {
+			continue
+		}
+
+		if kind, _ := c.Parent().ParentEdge(); kind == edge.GoStmt_Call {
+			goStmt, found = c.Parent().Parent(), true
+		}
+	}
+
+	return goStmt, found
+}
+
+// modified reports whether the identifier is incremented, decremented, assigned by a range statement,
+// the operand of an address operator or implicitly addressed as the receiver of a pointer method.
+func modified(info *types.Info, id inspector.Cursor) bool {
+	for c := id; ; c = c.Parent() {
+		switch kind, _ := c.ParentEdge(); kind {
+		case edge.ParenExpr_X:
+			continue
+
+		case edge.IncDecStmt_X:
+			return true
+
+		case edge.RangeStmt_Key, edge.RangeStmt_Value:
+			r, _ := c.Parent().Node().(*ast.RangeStmt)
+
+			return r != nil && r.Tok == token.ASSIGN
+
+		case edge.UnaryExpr_X:
+			u, _ := c.Parent().Node().(*ast.UnaryExpr)
+
+			return u != nil && u.Op == token.AND
+
+		case edge.SelectorExpr_X:
+			t := info.TypeOf(c.Node().(ast.Expr))
+			if t == nil {
+				return false
+			}
+
+			if _, ok := t.Underlying().(*types.Pointer); ok {
+				return false // Selections through pointers don't address the variable
+			}
+
+			sel, ok := info.Selections[c.Parent().Node().(*ast.SelectorExpr)]
+			if !ok {
+				return false
+			}
+
+			switch sel.Kind() {
+			case types.FieldVal:
+				continue // Fields of addressable structs are addressable
+
+			case types.MethodVal:
+				_, ptr := sel.Obj().Type().(*types.Signature).Recv().Type().Underlying().(*types.Pointer)
+
+				return ptr && !sel.Indirect() // Embedded pointers don't address the variable
+
+			default:
+				return false
+			}
+
+		default:
+			return false
+		}
+	}
+}
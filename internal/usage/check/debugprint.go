@@ -0,0 +1,62 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package check
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/ast/edge"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// DebugPrintArg reports whether the identifier is a direct argument of a debug print call:
+// fmt.Print, fmt.Printf, fmt.Println, their log package counterparts, or the print and println builtins.
+func DebugPrintArg(info *types.Info, id inspector.Cursor) bool {
+	if kind, _ := id.ParentEdge(); kind != edge.CallExpr_Args {
+		return false
+	}
+
+	call, ok := id.Parent().Node().(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+
+	switch fn := typeutil.Callee(info, call).(type) {
+	case *types.Builtin:
+		switch fn.Name() {
+		case "print", "println":
+			return true
+		}
+
+	case *types.Func:
+		if fn.Pkg() == nil || fn.Signature().Recv() != nil {
+			return false
+		}
+
+		switch fn.Pkg().Path() {
+		case "fmt", "log":
+			switch fn.Name() {
+			case "Print", "Printf", "Println":
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -69,6 +69,10 @@ type shadowInfo struct {
 
 	// decl is the inspector index of the inner declaration that shadows the outer variable.
 	decl astutil.NodeIndex
+
+	// ifInit indicates all shadowing declarations are in if statement initializers,
+	// so the inner variables are fully consumed within their if statements.
+	ifInit bool
 }
 
 // shadowing reports whether the given position falls within the active shadowing window.
@@ -84,7 +88,12 @@ func (sc *ShadowChecker) RecordShadowingDeclaration(scopes scope.UsageScope, v *
 	}
 
 	if s, start := scopes.Shadowing(v, id.NamePos); s != nil {
-		sc.shadowed[s] = shadowInfo{start: start, end: token.NoPos, decl: decl}
+		_, ifInit := scopes.Index[v.Parent()].(*ast.IfStmt)
+		if prev, ok := sc.shadowed[s]; ok {
+			ifInit = ifInit && prev.ifInit
+		}
+
+		sc.shadowed[s] = shadowInfo{start: start, end: token.NoPos, decl: decl, ifInit: ifInit}
 	}
 }
 
@@ -96,6 +105,32 @@ func (sc *ShadowChecker) RecordShadowedUse(v *types.Var, pos token.Pos, idx astu
 	}
 }
 
+// RecordShadowedReturn checks if the named result v is shadowed at the position of a bare return.
+// If it is, it records the usage.
+//
+// As a heuristic for the common error handling idiom
+//
+//	if err := f(); err != nil {
+//		return err
+//	}
+//
+//	return
+//
+// error results only shadowed by if statement initializers are not recorded when onlyFinal reports
+// that the result is only used by this final bare return.
+func (sc *ShadowChecker) RecordShadowedReturn(v *types.Var, pos token.Pos, idx astutil.NodeIndex, onlyFinal bool) {
+	if s, ok := sc.shadowed[v]; ok && s.shadowing(pos) {
+		if onlyFinal && s.ifInit && types.Identical(v.Type(), errorType) {
+			return
+		}
+
+		sc.recordUsedAfterShadow(v, idx, s.decl)
+	}
+}
+
+// errorType is the predeclared "error" type.
+var errorType = types.Universe.Lookup("error").Type()
+
 // recordUsedAfterShadow tracks the usage of a variable after it has been previously shadowed.
 func (sc *ShadowChecker) recordUsedAfterShadow(v *types.Var, use, decl astutil.NodeIndex) {
 	sc.usedAfterShadow = append(sc.usedAfterShadow, ShadowUse{Var: v, Use: use, Decl: decl})
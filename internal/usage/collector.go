@@ -53,6 +53,60 @@ type collector struct {
 
 	// current maps variables to their current (re)declaration.
 	current map[*types.Var]declUsage
+
+	// deadInit enables detection of initial values overwritten before being read.
+	deadInit bool
+
+	// deadInits collects declarations with dead initial values.
+	deadInits []DeadInit
+
+	// loopShadow enables detection of loop body declarations shadowing for loop variables.
+	loopShadow bool
+
+	// rangeShadow enables detection of range loop body declarations shadowing iteration variables.
+	rangeShadow bool
+
+	// loopShadows collects loop body declarations shadowing for loop variables.
+	loopShadows []LoopShadow
+
+	// branchInit enables detection of zero values overwritten on all branches of a following if statement.
+	branchInit bool
+
+	// branchInits collects declarations with zero values overwritten on all branches.
+	branchInits []BranchInit
+
+	// loopLast enables detection of variables only holding the final value assigned in a following loop.
+	loopLast bool
+
+	// loopLasts collects declarations of variables only holding the final value assigned in a loop.
+	loopLasts []LoopLast
+
+	// goCapture enables detection of variables only read inside a single goroutine function literal.
+	goCapture bool
+
+	// goCaptures collects declarations of variables only read inside a single goroutine function literal.
+	goCaptures []GoCapture
+
+	// noopShadow enables detection of short variable declarations redeclaring variables as themselves.
+	noopShadow bool
+
+	// noopShadows collects short variable declarations redeclaring variables as themselves.
+	noopShadows []NoopShadow
+
+	// loopConst enables detection of never reassigned variables with constant initializers used in loop conditions.
+	loopConst bool
+
+	// loopConsts collects declarations of variables that can be constants bounding loops.
+	loopConsts []LoopConst
+
+	// rewriteLoopConsts keeps loop bounds rewritten as constants from being moved.
+	rewriteLoopConsts bool
+
+	// ignoreDebug excludes arguments of debug prints from the usage scope.
+	ignoreDebug bool
+
+	// closures enables tracking declarations inside function literals.
+	closures bool
 }
 
 // declUsage tracks the scope and position of a variable's last declaration.
@@ -70,12 +124,19 @@ type declUsage struct {
 // result returns the collected usage information.
 func (c *collector) result() (Result, Diagnostics) {
 	return Result{
-			scopeRanges: c.scopeRanges,
-			usages:      c.usages,
-		}, Diagnostics{
-			Shadows: c.UsedAfterShadow(),
-			Nested:  c.NestedAssigned(),
-		}
+		scopeRanges: c.scopeRanges,
+		usages:      c.usages,
+	}, Diagnostics{
+		Shadows:     c.UsedAfterShadow(),
+		Nested:      c.NestedAssigned(),
+		DeadInits:   c.deadInits,
+		LoopShadows: c.loopShadows,
+		BranchInits: c.branchInits,
+		LoopLasts:   c.loopLasts,
+		GoCaptures:  c.goCaptures,
+		NoopShadows: c.noopShadows,
+		LoopConsts:  c.loopConsts,
+	}
 }
 
 // inspectBody traverses the AST of a function body to collect:
@@ -85,7 +146,9 @@ func (c *collector) result() (Result, Diagnostics) {
 //
 // For each declaration, it tracks the tightest scope containing all usages,
 // which determines if the declaration can be moved to a narrower scope.
-func (c *collector) inspectBody(body inspector.Cursor, results *ast.FieldList) {
+//
+// Without locals, only usages and assignments of variables declared outside the body are tracked.
+func (c *collector) inspectBody(body inspector.Cursor, results *ast.FieldList, locals bool) {
 	nodes := []ast.Node{
 		// keep-sorted start
 		(*ast.AssignStmt)(nil),
@@ -96,9 +159,13 @@ func (c *collector) inspectBody(body inspector.Cursor, results *ast.FieldList) {
 		// keep-sorted end
 	}
 
+	// Named results referenced explicitly, which are not only returned by bare returns
+	var referenced map[*types.Var]struct{}
+
 	if hasNamedResults(results) {
 		// We only need to check return statements for named results.
 		nodes = append(nodes, (*ast.ReturnStmt)(nil))
+		referenced = c.referencedResults(body, results)
 	}
 
 	body.Inspect(nodes, func(i inspector.Cursor) bool {
@@ -110,6 +177,10 @@ func (c *collector) inspectBody(body inspector.Cursor, results *ast.FieldList) {
 				c.handleAssignedVars(n.Lhs, n.End(), astutil.NodeIndexOf(i))
 
 			case token.DEFINE:
+				if !locals {
+					break
+				}
+
 				switch kind, _ := i.ParentEdge(); kind {
 				case edge.CommClause_Comm: // Don't consider short declarations in select cases
 					c.handleReceiveStmt(n, astutil.NodeIndexOf(i))
@@ -120,22 +191,57 @@ func (c *collector) inspectBody(body inspector.Cursor, results *ast.FieldList) {
 				}
 
 				c.handleShortDecl(n, astutil.NodeIndexOf(i))
+
+				if c.deadInit {
+					c.handleDeadInit(i, n)
+				}
+
+				if c.goCapture {
+					c.handleGoCapture(i)
+				}
+
+				if c.noopShadow {
+					c.handleNoopShadow(i, n)
+				}
+
+				if c.loopConst {
+					c.handleLoopConst(i, n)
+				}
 			}
 
 		case *ast.DeclStmt:
 			gen, ok := n.Decl.(*ast.GenDecl)
-			if !ok || gen.Tok != token.VAR {
+			if !ok || gen.Tok != token.VAR || !locals {
 				break
 			}
 
 			c.handleDeclStmt(gen, astutil.NodeIndexOf(i))
 
+			if c.branchInit {
+				c.handleBranchInit(i, gen)
+			}
+
+			if c.loopLast {
+				c.handleLoopLast(i, gen)
+			}
+
+			if c.goCapture {
+				c.handleGoCapture(i)
+			}
+
 		case *ast.FuncLit:
 			fbody, ftype := i.ChildAt(edge.FuncLit_Body, -1), n.Type
+			if !locals || !c.closures {
+				// Only track variables declared outside the function literal
+				c.inspectBody(fbody, nil, false)
+
+				return false
+			}
+
 			c.handleFunc(fbody, nil, ftype)
 
 			// Traverse recursively with different return values
-			c.inspectBody(fbody, ftype.Results)
+			c.inspectBody(fbody, ftype.Results, true)
 
 			return false // Visited recursively in inspectBody, do not descend
 
@@ -144,7 +250,7 @@ func (c *collector) inspectBody(body inspector.Cursor, results *ast.FieldList) {
 				break
 			}
 
-			c.handleIdent(n, astutil.NodeIndexOf(i))
+			c.handleIdent(n, astutil.NodeIndexOf(i), c.ignoreDebug && check.DebugPrintArg(c.TypesInfo, i), addressOf(i))
 
 		case *ast.RangeStmt:
 			if n.Key == nil {
@@ -156,7 +262,9 @@ func (c *collector) inspectBody(body inspector.Cursor, results *ast.FieldList) {
 				c.handleAssignedVars([]ast.Expr{n.Key, n.Value}, n.Body.Pos(), astutil.NodeIndexOf(i))
 
 			case token.DEFINE:
-				c.handleRangeStmt(n, astutil.NodeIndexOf(i))
+				if locals {
+					c.handleRangeStmt(n, astutil.NodeIndexOf(i))
+				}
 			}
 
 		case *ast.ReturnStmt:
@@ -164,16 +272,62 @@ func (c *collector) inspectBody(body inspector.Cursor, results *ast.FieldList) {
 				break
 			}
 
-			c.handleNamedResults(astutil.NodeIndexOf(i), results, n.Pos())
+			_, last := i.NextSibling()
+			final := i.Parent() == body && !last
+			c.handleNamedResults(astutil.NodeIndexOf(i), results, n.Pos(), final, referenced)
 
 			// keep-sorted end
 		}
 
 		return true
 	})
+
+	if hasNamedResults(results) && recovers(c.TypesInfo, body) {
+		// A recovered panic returns the named results, like a bare return at the end of the body
+		c.handleNamedResults(astutil.NodeIndexOf(body), results, body.Node().End(), false, referenced)
+	}
 }
 
 // hasNamedResults reports whether the function has named result parameters.
 func hasNamedResults(results *ast.FieldList) bool {
 	return results != nil && len(results.List) > 0 && len(results.List[0].Names) > 0
 }
+
+// recovers reports whether the function body defers a function literal calling recover,
+// so the function can return after a panic.
+func recovers(info *types.Info, body inspector.Cursor) bool {
+	found := false
+
+	body.Inspect([]ast.Node{(*ast.DeferStmt)(nil), (*ast.FuncLit)(nil)}, func(c inspector.Cursor) bool {
+		d, ok := c.Node().(*ast.DeferStmt)
+		if !ok || found {
+			return false // Defers in function literals don't affect this function
+		}
+
+		if lit, ok := ast.Unparen(d.Call.Fun).(*ast.FuncLit); ok && callsRecover(info, lit.Body) {
+			found = true
+		}
+
+		return false
+	})
+
+	return found
+}
+
+// callsRecover reports whether the block calls the recover built-in.
+func callsRecover(info *types.Info, body *ast.BlockStmt) bool {
+	for n := range ast.Preorder(body) {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			continue
+		}
+
+		if id, ok := ast.Unparen(call.Fun).(*ast.Ident); ok {
+			if b, ok := info.Uses[id].(*types.Builtin); ok && b.Name() == "recover" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,216 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package usage
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/ast/edge"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"fillmore-labs.com/scopeguard/internal/astutil"
+)
+
+// handleDeadInit checks whether the initial values of a short variable declaration are
+// overwritten by the immediately following assignment before being read:
+//
+//	x := 0
+//	x = compute()
+//
+// Only the next statement in the same statement list is considered, so the assignment
+// is executed unconditionally after the declaration.
+func (c *collector) handleDeadInit(decl inspector.Cursor, stmt *ast.AssignStmt) {
+	switch kind, _ := decl.ParentEdge(); kind {
+	case edge.BlockStmt_List, edge.CaseClause_Body, edge.CommClause_Body:
+
+	default:
+		return // Not part of a statement list (e.g. an init statement)
+	}
+
+	next, ok := decl.NextSibling()
+	if !ok {
+		return
+	}
+
+	asgn, ok := next.Node().(*ast.AssignStmt)
+	if !ok || asgn.Tok != token.ASSIGN {
+		return
+	}
+
+	declared := c.declaredVars(stmt)
+	if len(declared) == 0 {
+		return
+	}
+
+	read := c.readVars(next, declared)
+
+	var (
+		dead    []*types.Var
+		fixable = len(stmt.Lhs) == len(stmt.Rhs)
+		seen    = make(map[*types.Var]struct{}, len(declared))
+	)
+
+	for idx, expr := range asgn.Lhs {
+		id, ok := ast.Unparen(expr).(*ast.Ident)
+		if !ok {
+			fixable = false
+			continue
+		}
+
+		if id.Name == "_" {
+			continue
+		}
+
+		v, ok := c.TypesInfo.Uses[id].(*types.Var)
+		if _, isDeclared := declared[v]; !ok || !isDeclared {
+			fixable = false // Assignment to another variable, can't be turned into a declaration
+			continue
+		}
+
+		if _, ok := seen[v]; ok {
+			continue // x, x = ...
+		}
+
+		seen[v] = struct{}{}
+
+		if _, ok := read[v]; ok {
+			fixable = false
+			continue
+		}
+
+		if !types.Identical(v.Type(), assignedType(c.TypesInfo, asgn, idx)) {
+			fixable = false // Merging would change the inferred type
+		}
+
+		dead = append(dead, v)
+	}
+
+	if len(dead) == 0 {
+		return
+	}
+
+	// Merging drops the initializers, so every declared variable must be overwritten
+	// and the initializers must not have side effects.
+	if len(dead) != len(declared) {
+		fixable = false
+	}
+
+	for _, expr := range stmt.Rhs {
+		if !fixable {
+			break
+		}
+
+		fixable = sideEffectFree(c.TypesInfo, expr)
+	}
+
+	c.deadInits = append(c.deadInits, DeadInit{
+		Decl:    astutil.NodeIndexOf(decl),
+		Asgn:    astutil.NodeIndexOf(next),
+		Vars:    dead,
+		Fixable: fixable,
+	})
+}
+
+// declaredVars returns the variables newly declared by a short variable declaration.
+// It returns nil if the declaration also reassigns existing variables.
+func (c *collector) declaredVars(stmt *ast.AssignStmt) map[*types.Var]struct{} {
+	declared := make(map[*types.Var]struct{}, len(stmt.Lhs))
+
+	for _, expr := range stmt.Lhs {
+		id, ok := expr.(*ast.Ident)
+		if !ok {
+			return nil
+		}
+
+		if id.Name == "_" {
+			continue
+		}
+
+		v, ok := c.TypesInfo.Defs[id].(*types.Var)
+		if !ok {
+			return nil // Redeclaration of an existing variable
+		}
+
+		declared[v] = struct{}{}
+	}
+
+	return declared
+}
+
+// readVars returns the variables from vars that are read in the assignment statement.
+// Identifiers directly on the left-hand side are written, not read.
+func (c *collector) readVars(asgn inspector.Cursor, vars map[*types.Var]struct{}) map[*types.Var]struct{} {
+	read := make(map[*types.Var]struct{})
+
+	for i := range asgn.Preorder((*ast.Ident)(nil)) {
+		id, ok := i.Node().(*ast.Ident)
+		if !ok {
+			continue
+		}
+
+		v, ok := c.TypesInfo.Uses[id].(*types.Var)
+		if !ok {
+			continue
+		}
+
+		if _, ok := vars[v]; !ok {
+			continue
+		}
+
+		if directlyAssigned(i) {
+			continue
+		}
+
+		read[v] = struct{}{}
+	}
+
+	return read
+}
+
+// directlyAssigned reports whether the identifier is a (possibly parenthesized) left-hand side of an assignment.
+func directlyAssigned(id inspector.Cursor) bool {
+	for c := id; ; c = c.Parent() {
+		switch kind, _ := c.ParentEdge(); kind {
+		case edge.ParenExpr_X:
+			continue
+
+		case edge.AssignStmt_Lhs:
+			return true
+
+		default:
+			return false
+		}
+	}
+}
+
+// sideEffectFree reports whether evaluating the expression has no side effects.
+func sideEffectFree(info *types.Info, expr ast.Expr) bool {
+	tv, ok := info.Types[expr]
+	if !ok {
+		return false
+	}
+
+	if tv.Value != nil || tv.IsNil() {
+		return true // Constant or nil
+	}
+
+	_, ok = ast.Unparen(expr).(*ast.Ident) // Variable read
+
+	return ok
+}
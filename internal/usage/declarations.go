@@ -154,6 +154,51 @@ func (c *collector) recordDeclaration(decl astutil.NodeIndex, start token.Pos, i
 	c.current[v] = declUsage{start: start, ignore: id.NamePos}
 
 	c.RecordShadowingDeclaration(c.UsageScope, v, id, decl)
+
+	if c.loopShadow || c.rangeShadow {
+		c.recordLoopShadow(v, decl)
+	}
+}
+
+// recordLoopShadow records v when it is declared at the top level of a for loop body
+// and shadows a variable declared in the init statement of that loop.
+//
+// Range loops are only considered with range shadow detection, since redeclaring the iteration
+// variable (v := process(v)) is a common idiom there. Only redeclarations of the same type are
+// recorded for them, conversions like v := T(v) are not redundant.
+func (c *collector) recordLoopShadow(v *types.Var, decl astutil.NodeIndex) {
+	body := v.Parent()
+	if body == nil {
+		return
+	}
+
+	loop := body.Parent()
+
+	var isRange bool
+
+	switch c.Index[loop].(type) {
+	case *ast.ForStmt:
+		if !c.loopShadow {
+			return
+		}
+
+	case *ast.RangeStmt:
+		if !c.rangeShadow {
+			return
+		}
+
+		isRange = true
+
+	default:
+		return
+	}
+
+	shadowed, ok := loop.Lookup(v.Name()).(*types.Var)
+	if !ok || isRange && !types.Identical(v.Type(), shadowed.Type()) {
+		return
+	}
+
+	c.loopShadows = append(c.loopShadows, LoopShadow{Decl: decl, Var: v, Shadowed: shadowed, Range: isRange})
 }
 
 // notMovable marks a variable declaration as non-movable by setting its usage scope to its declaration scope.
@@ -0,0 +1,112 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package usage
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/ast/edge"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"fillmore-labs.com/scopeguard/internal/astutil"
+)
+
+// handleLoopConst checks whether a short variable declaration initialized with a constant declares
+// a variable that is never reassigned and used in a for loop condition:
+//
+//	limit := 10
+//	for i := 0; i < limit; i++ {
+//
+// Such a variable can be declared as a constant, const limit = 10, which keeps the default type
+// of the initializer.
+func (c *collector) handleLoopConst(decl inspector.Cursor, n *ast.AssignStmt) {
+	switch kind, _ := decl.ParentEdge(); kind {
+	case edge.BlockStmt_List, edge.CaseClause_Body, edge.CommClause_Body:
+
+	default:
+		return
+	}
+
+	if len(n.Lhs) != 1 || len(n.Rhs) != 1 {
+		return
+	}
+
+	id, ok := n.Lhs[0].(*ast.Ident)
+	if !ok {
+		return
+	}
+
+	v, ok := c.TypesInfo.Defs[id].(*types.Var)
+	if !ok {
+		return
+	}
+
+	if tv, ok := c.TypesInfo.Types[n.Rhs[0]]; !ok || tv.Value == nil {
+		return // Not a constant initializer
+	}
+
+	if _, ok := v.Type().Underlying().(*types.Basic); !ok {
+		return
+	}
+
+	if !c.loopBound(decl, v) {
+		return
+	}
+
+	idx := astutil.NodeIndexOf(decl)
+	if c.rewriteLoopConsts {
+		c.notMovable(idx, v) // Rewritten instead
+	}
+
+	c.loopConsts = append(c.loopConsts, LoopConst{Decl: idx, Var: v})
+}
+
+// loopBound reports whether v is only read by the statements following the declaration
+// and used in the condition of a for loop.
+func (c *collector) loopBound(decl inspector.Cursor, v *types.Var) bool {
+	inCond := false
+
+	for stmt, ok := decl.NextSibling(); ok; stmt, ok = stmt.NextSibling() {
+		for i := range stmt.Preorder((*ast.Ident)(nil)) {
+			if c.TypesInfo.Uses[i.Node().(*ast.Ident)] != v {
+				continue
+			}
+
+			if directlyAssigned(i) || modified(c.TypesInfo, i) {
+				return false
+			}
+
+			inCond = inCond || inLoopCond(i)
+		}
+	}
+
+	return inCond
+}
+
+// inLoopCond reports whether the identifier is part of the condition of a for statement.
+func inLoopCond(id inspector.Cursor) bool {
+	for c := id; ; c = c.Parent() {
+		switch kind, _ := c.ParentEdge(); kind {
+		case edge.ForStmt_Cond:
+			return true
+
+		case edge.Invalid, edge.BlockStmt_List, edge.FuncLit_Body:
+			return false
+		}
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package usage
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/ast/edge"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"fillmore-labs.com/scopeguard/internal/astutil"
+)
+
+// handleLoopLast checks whether variables of a var declaration without initializers only hold
+// the final value assigned in the immediately following loop:
+//
+//	var last int
+//	for _, v := range xs {
+//		last = v
+//	}
+//	fmt.Println(last)
+//
+// The variable must only be written in the loop body, never read there or in the loop header,
+// and be read exactly once in the statements following the loop.
+func (c *collector) handleLoopLast(decl inspector.Cursor, gen *ast.GenDecl) {
+	switch kind, _ := decl.ParentEdge(); kind {
+	case edge.BlockStmt_List, edge.CaseClause_Body, edge.CommClause_Body:
+
+	default:
+		return
+	}
+
+	next, ok := decl.NextSibling()
+	if !ok {
+		return
+	}
+
+	var header []ast.Node
+
+	var body *ast.BlockStmt
+
+	switch loop := next.Node().(type) {
+	case *ast.RangeStmt:
+		header, body = []ast.Node{loop.Key, loop.Value, loop.X}, loop.Body
+
+	case *ast.ForStmt:
+		header, body = []ast.Node{loop.Init, loop.Cond, loop.Post}, loop.Body
+
+	default:
+		return
+	}
+
+	var vars []*types.Var
+
+	for _, spec := range gen.Specs {
+		vspec, ok := spec.(*ast.ValueSpec)
+		if !ok || len(vspec.Values) > 0 {
+			continue
+		}
+
+	names:
+		for _, id := range vspec.Names {
+			v, ok := c.TypesInfo.Defs[id].(*types.Var)
+			if !ok {
+				continue
+			}
+
+			for _, n := range header {
+				if c.mentions(n, v) {
+					continue names
+				}
+			}
+
+			if c.onlyAssigned(body, v) && c.readOnceAfter(next, v) {
+				vars = append(vars, v)
+			}
+		}
+	}
+
+	if len(vars) == 0 {
+		return
+	}
+
+	c.loopLasts = append(c.loopLasts, LoopLast{
+		Decl: astutil.NodeIndexOf(decl),
+		Loop: astutil.NodeIndexOf(next),
+		Vars: vars,
+	})
+}
+
+// onlyAssigned reports whether v is assigned in the loop body, but never read there.
+func (c *collector) onlyAssigned(body *ast.BlockStmt, v *types.Var) bool {
+	var mentioned, assigned int
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if n.Tok == token.ASSIGN && c.assignsVar(n, v) {
+				for _, expr := range n.Lhs {
+					if id, ok := ast.Unparen(expr).(*ast.Ident); ok && c.TypesInfo.Uses[id] == v {
+						assigned++
+					}
+				}
+			}
+
+		case *ast.Ident:
+			if c.TypesInfo.Uses[n] == v {
+				mentioned++
+			}
+		}
+
+		return true
+	})
+
+	return assigned > 0 && assigned == mentioned
+}
+
+// readOnceAfter reports whether v is read exactly once and not assigned in the statements following the loop.
+func (c *collector) readOnceAfter(loop inspector.Cursor, v *types.Var) bool {
+	reads := 0
+
+	for stmt, ok := loop.NextSibling(); ok; stmt, ok = stmt.NextSibling() {
+		for i := range stmt.Preorder((*ast.Ident)(nil)) {
+			if c.TypesInfo.Uses[i.Node().(*ast.Ident)] != v {
+				continue
+			}
+
+			if directlyAssigned(i) {
+				return false
+			}
+
+			reads++
+		}
+	}
+
+	return reads == 1
+}
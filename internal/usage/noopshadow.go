@@ -0,0 +1,137 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package usage
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/ast/edge"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"fillmore-labs.com/scopeguard/internal/astutil"
+)
+
+// handleNoopShadow checks whether a short variable declaration only redeclares variables as themselves:
+//
+//	x := x
+//
+// When the new variables are only read and never captured by a function literal, they always hold the value
+// of the shadowed variables, so the declaration can be removed.
+func (c *collector) handleNoopShadow(decl inspector.Cursor, n *ast.AssignStmt) {
+	switch kind, _ := decl.ParentEdge(); kind {
+	case edge.BlockStmt_List, edge.CaseClause_Body, edge.CommClause_Body:
+
+	default:
+		return
+	}
+
+	if len(n.Lhs) != len(n.Rhs) {
+		return
+	}
+
+	vars := make([]*types.Var, 0, len(n.Lhs))
+
+	for i, lhs := range n.Lhs {
+		id, ok := lhs.(*ast.Ident)
+		if !ok {
+			return
+		}
+
+		rhs, ok := ast.Unparen(n.Rhs[i]).(*ast.Ident)
+		if !ok || rhs.Name != id.Name {
+			return // Not a redeclaration as itself, like x := x.(T) or x := &x
+		}
+
+		v, ok := c.TypesInfo.Defs[id].(*types.Var)
+		if !ok {
+			return
+		}
+
+		if shadowed, ok := c.TypesInfo.Uses[rhs].(*types.Var); !ok || !types.Identical(v.Type(), shadowed.Type()) {
+			return
+		}
+
+		if !c.onlyRead(decl, v) {
+			return
+		}
+
+		vars = append(vars, v)
+	}
+
+	idx := astutil.NodeIndexOf(decl)
+	for _, v := range vars {
+		c.notMovable(idx, v) // Removed instead
+	}
+
+	c.noopShadows = append(c.noopShadows, NoopShadow{Decl: idx, Vars: vars})
+}
+
+// onlyRead reports whether v is only read by the statements following the declaration,
+// without being captured by a function literal.
+func (c *collector) onlyRead(decl inspector.Cursor, v *types.Var) bool {
+	shared := sharesState(v.Type())
+
+	for stmt, ok := decl.NextSibling(); ok; stmt, ok = stmt.NextSibling() {
+		for i := range stmt.Preorder((*ast.Ident)(nil)) {
+			if c.TypesInfo.Uses[i.Node().(*ast.Ident)] != v {
+				continue
+			}
+
+			if directlyAssigned(i) || modified(c.TypesInfo, i) || !shared && partAccessed(i) {
+				return false
+			}
+
+			for p := i.Parent(); p != stmt.Parent(); p = p.Parent() {
+				if _, ok := p.Node().(*ast.FuncLit); ok {
+					return false // Captured, the copy might be observed after the shadowed variable changes
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// sharesState reports whether copies of values of type t refer to the same state,
+// so modifications through the copy are visible in the original.
+func sharesState(t types.Type) bool {
+	switch t.Underlying().(type) {
+	case *types.Basic, *types.Pointer, *types.Slice, *types.Map, *types.Chan, *types.Signature, *types.Interface:
+		return true
+
+	default:
+		return false
+	}
+}
+
+// partAccessed reports whether the identifier is the operand of a selector, index, slice or range expression,
+// which might modify a part of the variable or call a method with pointer receiver.
+func partAccessed(id inspector.Cursor) bool {
+	for c := id; ; c = c.Parent() {
+		switch kind, _ := c.ParentEdge(); kind {
+		case edge.ParenExpr_X:
+			continue
+
+		case edge.SelectorExpr_X, edge.IndexExpr_X, edge.IndexListExpr_X, edge.SliceExpr_X, edge.RangeStmt_X:
+			return true
+
+		default:
+			return false
+		}
+	}
+}
@@ -21,11 +21,17 @@ import (
 	"go/token"
 	"go/types"
 
+	"golang.org/x/tools/go/ast/edge"
+	"golang.org/x/tools/go/ast/inspector"
+
 	"fillmore-labs.com/scopeguard/internal/astutil"
 )
 
 // handleIdent processes identifier usages.
-func (c *collector) handleIdent(id *ast.Ident, idx astutil.NodeIndex) {
+//
+// Arguments of ignored debug prints mark the declaration as used without extending its usage scope.
+// Operands of address operators additionally mark the declaration as address-taken.
+func (c *collector) handleIdent(id *ast.Ident, idx astutil.NodeIndex, debugPrint, addressTaken bool) {
 	v, ok := c.TypesInfo.Uses[id].(*types.Var)
 	if !ok {
 		return
@@ -45,11 +51,68 @@ func (c *collector) handleIdent(id *ast.Ident, idx astutil.NodeIndex) {
 
 	usage.Usage |= UsageUsed
 
+	if addressTaken {
+		usage.Usage |= UsageAddressTaken
+	}
+
+	if debugPrint {
+		usage.Usage |= UsageDebugPrint
+		return
+	}
+
 	c.updateUsageScope(usage.Decl, v, id)
 }
 
+// addressOf reports whether the identifier is the operand of an address operator.
+func addressOf(id inspector.Cursor) bool {
+	for c := id; ; c = c.Parent() {
+		switch kind, _ := c.ParentEdge(); kind {
+		case edge.ParenExpr_X:
+			continue
+
+		case edge.UnaryExpr_X:
+			u, _ := c.Parent().Node().(*ast.UnaryExpr)
+
+			return u != nil && u.Op == token.AND
+
+		default:
+			return false
+		}
+	}
+}
+
+// referencedResults returns the named results referenced explicitly in the function body.
+func (c *collector) referencedResults(body inspector.Cursor, results *ast.FieldList) map[*types.Var]struct{} {
+	if !hasNamedResults(results) {
+		return nil
+	}
+
+	named := make(map[*types.Var]struct{})
+	for _, field := range results.List {
+		for _, id := range field.Names {
+			if v, ok := c.TypesInfo.Defs[id].(*types.Var); ok {
+				named[v] = struct{}{}
+			}
+		}
+	}
+
+	referenced := make(map[*types.Var]struct{})
+	for i := range body.Preorder((*ast.Ident)(nil)) {
+		if v, ok := c.TypesInfo.Uses[i.Node().(*ast.Ident)].(*types.Var); ok {
+			if _, ok := named[v]; ok {
+				referenced[v] = struct{}{}
+			}
+		}
+	}
+
+	return referenced
+}
+
 // handleNamedResults marks named result parameters as used when a bare return is encountered.
-func (c *collector) handleNamedResults(idx astutil.NodeIndex, results *ast.FieldList, pos token.Pos) {
+//
+// final reports whether this is the last statement of the function body, and referenced holds
+// the named results referenced explicitly in the body.
+func (c *collector) handleNamedResults(idx astutil.NodeIndex, results *ast.FieldList, pos token.Pos, final bool, referenced map[*types.Var]struct{}) {
 	if results == nil {
 		return
 	}
@@ -65,7 +128,8 @@ func (c *collector) handleNamedResults(idx astutil.NodeIndex, results *ast.Field
 				continue
 			}
 
-			c.RecordShadowedUse(v, pos, idx)
+			_, explicit := referenced[v]
+			c.RecordShadowedReturn(v, pos, idx, final && !explicit)
 
 			usages := c.usages[v]
 			if len(usages) == 0 {
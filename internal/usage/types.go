@@ -52,6 +52,12 @@ const (
 	// UsageUntypedNil indicates the variable redeclaration is assigned to untyped nil.
 	UsageUntypedNil
 
+	// UsageDebugPrint indicates the variable declaration is used as an argument of an ignored debug print.
+	UsageDebugPrint
+
+	// UsageAddressTaken indicates the address of the variable is taken.
+	UsageAddressTaken
+
 	// UsageNone indicates the variable declaration is unused.
 	UsageNone Flags = 0
 
@@ -74,6 +80,16 @@ func (f Flags) UntypedNil() bool {
 	return f&UsageUntypedNil != 0
 }
 
+// DebugPrint returns true if the variable declaration is used as an argument of an ignored debug print.
+func (f Flags) DebugPrint() bool {
+	return f&UsageDebugPrint != 0
+}
+
+// AddressTaken returns true if the address of the variable is taken.
+func (f Flags) AddressTaken() bool {
+	return f&UsageAddressTaken != 0
+}
+
 // UsedAndTypeChange represents a combination of [Flags.Used] and [Flags.TypeChange].
 func (f Flags) UsedAndTypeChange() bool {
 	return f&UsageUsedAndTypeChange == UsageUsedAndTypeChange
@@ -98,6 +114,13 @@ func (u Result) AllScopeRanges() iter.Seq2[astutil.NodeIndex, ScopeRange] {
 	return maps.All(u.scopeRanges)
 }
 
+// ScopeRange returns the scope range of a declaration, if it is tracked.
+func (u Result) ScopeRange(decl astutil.NodeIndex) (ScopeRange, bool) {
+	r, ok := u.scopeRanges[decl]
+
+	return r, ok
+}
+
 // AllUsages returns an iterator over all variables and their corresponding usage lists.
 func (u Result) AllUsages() iter.Seq2[*types.Var, []NodeUsage] {
 	return maps.All(u.usages)
@@ -105,8 +128,91 @@ func (u Result) AllUsages() iter.Seq2[*types.Var, []NodeUsage] {
 
 // Diagnostics contains findings from the usage analysis stage.
 type Diagnostics struct {
-	Shadows []ShadowUse
-	Nested  []NestedAssign
+	Shadows     []ShadowUse
+	Nested      []NestedAssign
+	DeadInits   []DeadInit
+	LoopShadows []LoopShadow
+	BranchInits []BranchInit
+	LoopLasts   []LoopLast
+	GoCaptures  []GoCapture
+	NoopShadows []NoopShadow
+	LoopConsts  []LoopConst
+}
+
+// BranchInit contains information about a var declaration whose zero values are
+// overwritten on all branches of the immediately following if statement.
+type BranchInit struct {
+	// Decl is the var declaration, If the if statement assigning the variables.
+	Decl, If astutil.NodeIndex
+
+	// Vars are the variables whose zero values are dead.
+	Vars []*types.Var
+}
+
+// LoopLast contains information about a var declaration whose variables only hold
+// the final value assigned in the immediately following loop.
+type LoopLast struct {
+	// Decl is the var declaration, Loop the loop assigning the variables.
+	Decl, Loop astutil.NodeIndex
+
+	// Vars are the variables only holding the final value.
+	Vars []*types.Var
+}
+
+// GoCapture contains information about a declaration whose variables are only read inside
+// the function literal of a single go statement.
+type GoCapture struct {
+	// Decl is the declaration, Go the go statement capturing the variables.
+	Decl, Go astutil.NodeIndex
+
+	// Vars are the captured variables.
+	Vars []*types.Var
+}
+
+// NoopShadow contains information about a short variable declaration redeclaring variables as themselves.
+type NoopShadow struct {
+	// Decl is the short variable declaration.
+	Decl astutil.NodeIndex
+
+	// Vars are the redundant variables.
+	Vars []*types.Var
+}
+
+// LoopConst contains information about a short variable declaration of a never reassigned variable
+// with a constant initializer, used in a for loop condition.
+type LoopConst struct {
+	// Decl is the short variable declaration.
+	Decl astutil.NodeIndex
+
+	// Var is the variable that can be a constant.
+	Var *types.Var
+}
+
+// LoopShadow contains information about a declaration in a for or range loop body shadowing a loop variable.
+type LoopShadow struct {
+	// Decl is the declaration in the loop body.
+	Decl astutil.NodeIndex
+
+	// Var is the declared variable, Shadowed the loop variable declared in the for statement initializer
+	// or range clause.
+	Var, Shadowed *types.Var
+
+	// Range indicates the shadowed variable is a range loop iteration variable.
+	Range bool
+}
+
+// DeadInit contains information about a short declaration whose initial values are
+// overwritten by the immediately following assignment before being read.
+type DeadInit struct {
+	// Decl is the short variable declaration with dead initial values.
+	// Asgn is the assignment overwriting them.
+	Decl, Asgn astutil.NodeIndex
+
+	// Vars are the variables whose initial values are dead.
+	Vars []*types.Var
+
+	// Fixable indicates the declaration can be merged into the assignment without changing semantics.
+	Fixable bool
 }
 
 type (
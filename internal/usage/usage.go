@@ -19,6 +19,7 @@ package usage
 import (
 	"context"
 	"go/ast"
+	"go/token"
 	"go/types"
 	"runtime/trace"
 
@@ -37,6 +38,16 @@ type Stage struct {
 	*analysis.Pass
 	scope.UsageScope
 	Analyzers config.BitMask[config.AnalyzerFlags]
+
+	// IgnoreDebugPrints treats uses as arguments of debug prints as not restricting the usage scope.
+	IgnoreDebugPrints bool
+
+	// SkipClosures omits tracking declarations inside function literals.
+	SkipClosures bool
+
+	// RewriteLoopConsts reports that suggested fixes rewrite loop bounds as constants,
+	// so they are not reported as movable.
+	RewriteLoopConsts bool
 }
 
 // TrackUsage collects variable declarations and tracks their usages to determine the minimum scope.
@@ -46,11 +57,45 @@ func (us Stage) TrackUsage(ctx context.Context, body inspector.Cursor, f *ast.Fu
 	uc := us.newUsageCollector()
 
 	uc.handleFunc(body, f.Recv, f.Type)
-	uc.inspectBody(body, f.Type.Results)
+	uc.inspectBody(body, f.Type.Results, true)
 
 	return uc.result()
 }
 
+// HasDeclarations reports whether a function body contains local variable declarations or function literals.
+//
+// Bodies without either have nothing to report, so the usage collection can be skipped entirely:
+// all findings depend on a local declaration, except nested assignments, which require a function literal.
+func HasDeclarations(body inspector.Cursor) bool {
+	nodes := []ast.Node{
+		// keep-sorted start
+		(*ast.AssignStmt)(nil),
+		(*ast.DeclStmt)(nil),
+		(*ast.FuncLit)(nil),
+		(*ast.RangeStmt)(nil),
+		// keep-sorted end
+	}
+
+	for c := range body.Preorder(nodes...) {
+		switch n := c.Node().(type) {
+		case *ast.AssignStmt:
+			if n.Tok == token.DEFINE {
+				return true
+			}
+
+		case *ast.RangeStmt:
+			if n.Tok == token.DEFINE {
+				return true
+			}
+
+		case *ast.DeclStmt, *ast.FuncLit:
+			return true
+		}
+	}
+
+	return false
+}
+
 // newUsageCollector creates a new usage collector for analyzing a function body.
 func (us Stage) newUsageCollector() collector {
 	var scopeRanges map[astutil.NodeIndex]ScopeRange
@@ -60,12 +105,23 @@ func (us Stage) newUsageCollector() collector {
 	}
 
 	return collector{
-		Pass:          us.Pass,
-		UsageScope:    us.UsageScope,
-		ShadowChecker: check.NewShadowChecker(us.Analyzers.Enabled(config.ShadowAnalyzer)),
-		NestedChecker: check.NewNestedChecker(us.Analyzers.Enabled(config.NestedAssignAnalyzer)),
-		scopeRanges:   scopeRanges,
-		current:       make(map[*types.Var]declUsage),
-		usages:        make(map[*types.Var][]NodeUsage),
+		Pass:              us.Pass,
+		UsageScope:        us.UsageScope,
+		ShadowChecker:     check.NewShadowChecker(us.Analyzers.Enabled(config.ShadowAnalyzer)),
+		NestedChecker:     check.NewNestedChecker(us.Analyzers.Enabled(config.NestedAssignAnalyzer)),
+		scopeRanges:       scopeRanges,
+		deadInit:          us.Analyzers.Enabled(config.DeadInitAnalyzer),
+		loopShadow:        us.Analyzers.Enabled(config.LoopShadowAnalyzer),
+		rangeShadow:       us.Analyzers.Enabled(config.RangeShadowAnalyzer),
+		branchInit:        us.Analyzers.Enabled(config.BranchInitAnalyzer),
+		loopLast:          us.Analyzers.Enabled(config.LoopLastAnalyzer),
+		goCapture:         us.Analyzers.Enabled(config.GoCaptureAnalyzer),
+		noopShadow:        us.Analyzers.Enabled(config.NoopShadowAnalyzer),
+		loopConst:         us.Analyzers.Enabled(config.LoopConstAnalyzer),
+		rewriteLoopConsts: us.RewriteLoopConsts,
+		ignoreDebug:       us.IgnoreDebugPrints,
+		closures:          !us.SkipClosures,
+		current:           make(map[*types.Var]declUsage),
+		usages:            make(map[*types.Var][]NodeUsage),
 	}
 }
@@ -18,6 +18,7 @@ package main
 
 import (
 	"flag"
+	"os"
 
 	"golang.org/x/tools/go/analysis/singlechecker"
 
@@ -25,7 +26,7 @@ import (
 )
 
 func main() {
-	a := analyzer.Analyzer
+	a := analyzer.New(analyzer.WithColor(colorTerminal(os.Stderr)))
 
 	if flag.Lookup("V") == nil {
 		flag.BoolFunc("V", "print version and exit", version)
@@ -33,3 +34,15 @@ func main() {
 
 	singlechecker.Main(a)
 }
+
+// colorTerminal reports whether diagnostics written to f should be colored by default:
+// f is a terminal and the NO_COLOR environment variable is not set.
+func colorTerminal(f *os.File) bool {
+	if _, ok := os.LookupEnv("NO_COLOR"); ok {
+		return false
+	}
+
+	fi, err := f.Stat()
+
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}